@@ -0,0 +1,211 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// assumeGrantType is the grant type of the IAM token API that exchanges a token for the token of
+	// a trusted profile
+	assumeGrantType = "urn:ibm:params:oauth:grant-type:assume"
+
+	// assumeRefreshWindow is the part of the lifetime of an assumed token after which it is refreshed
+	assumeRefreshWindow = 0.8
+)
+
+// AssumeTrustedProfile is a trusted profile that is assumed with the token of the previous
+// credential of the chain, to act in the account of the profile
+type AssumeTrustedProfile struct {
+	// ProfileID, ProfileName or ProfileCRN identifies the trusted profile
+	ProfileID   string
+	ProfileName string
+	ProfileCRN  string
+	// AccountID is the account of the trusted profile, which is required with ProfileName
+	AccountID string
+}
+
+// Validate returns an error when the trusted profile is not identified by exactly one of its ID,
+// name or CRN
+func (p AssumeTrustedProfile) Validate() error {
+	identifiers := 0
+	for _, identifier := range []string{p.ProfileID, p.ProfileName, p.ProfileCRN} {
+		if identifier != "" {
+			identifiers++
+		}
+	}
+	if identifiers != 1 {
+		return fmt.Errorf("exactly one of the profile ID, name or CRN of an assumed trusted profile is required")
+	}
+	if p.ProfileName != "" && p.AccountID == "" {
+		return fmt.Errorf("the account ID of the assumed trusted profile %s is required with its name", p.ProfileName)
+	}
+	return nil
+}
+
+func (p AssumeTrustedProfile) String() string {
+	switch {
+	case p.ProfileID != "":
+		return p.ProfileID
+	case p.ProfileCRN != "":
+		return p.ProfileCRN
+	}
+	return fmt.Sprintf("%s/%s", p.AccountID, p.ProfileName)
+}
+
+// assumeAuthenticator authenticates with the token of a trusted profile, which is exchanged for the
+// token of its base authenticator. The token is refreshed before it expires.
+type assumeAuthenticator struct {
+	base    core.Authenticator
+	profile AssumeTrustedProfile
+	url     string
+	client  *http.Client
+
+	mu         sync.Mutex
+	token      string
+	expiration time.Time
+	refreshAt  time.Time
+}
+
+// assumeTokenResponse is the response of the IAM token API
+type assumeTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Expiration  int64  `json:"expiration"`
+}
+
+// newAssumeAuthenticator returns an authenticator that assumes the trusted profiles in order, the
+// first one with the token of the base authenticator and the next ones with the token of the
+// previous profile
+func newAssumeAuthenticator(base core.Authenticator, profiles []AssumeTrustedProfile, iamURL string, client *http.Client) (core.Authenticator, error) {
+	if base == nil {
+		return nil, fmt.Errorf("a credential is required to assume a trusted profile")
+	}
+	if client == nil {
+		client = core.DefaultHTTPClient()
+		client.Timeout = 30 * time.Second
+	}
+	authenticator := base
+	for _, profile := range profiles {
+		if err := profile.Validate(); err != nil {
+			return nil, err
+		}
+		authenticator = &assumeAuthenticator{
+			base:    authenticator,
+			profile: profile,
+			url:     strings.TrimSuffix(iamURL, "/") + "/identity/token",
+			client:  client,
+		}
+	}
+	return authenticator, nil
+}
+
+// AuthenticationType returns the type of the authenticator
+func (a *assumeAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_IAM_ASSUME
+}
+
+// Validate returns an error when the trusted profile or the base authenticator is not valid
+func (a *assumeAuthenticator) Validate() error {
+	if err := a.profile.Validate(); err != nil {
+		return err
+	}
+	return a.base.Validate()
+}
+
+// Authenticate adds the token of the trusted profile to the request
+func (a *assumeAuthenticator) Authenticate(request *http.Request) error {
+	token, err := a.GetToken()
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", bearerPrefix+token)
+	return nil
+}
+
+// GetToken returns the token of the trusted profile, which is requested when it is about to expire
+func (a *assumeAuthenticator) GetToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.token != "" && now.Before(a.refreshAt) {
+		return a.token, nil
+	}
+	token, expiresIn, err := a.requestToken()
+	if err != nil {
+		// the current token is still used when it could not be refreshed before its expiration
+		if a.token != "" && now.Before(a.expiration) {
+			return a.token, nil
+		}
+		return "", err
+	}
+	a.token = token
+	a.expiration = now.Add(expiresIn)
+	a.refreshAt = now.Add(time.Duration(float64(expiresIn) * assumeRefreshWindow))
+	return a.token, nil
+}
+
+func (a *assumeAuthenticator) requestToken() (string, time.Duration, error) {
+	// the token of the base authenticator is the one that it adds to a request
+	baseRequest := &http.Request{Header: make(http.Header)}
+	if err := a.base.Authenticate(baseRequest); err != nil {
+		return "", 0, err
+	}
+	baseToken := strings.TrimPrefix(baseRequest.Header.Get("Authorization"), bearerPrefix)
+
+	form := url.Values{
+		"grant_type":   {assumeGrantType},
+		"access_token": {baseToken},
+	}
+	switch {
+	case a.profile.ProfileID != "":
+		form.Set("profile_id", a.profile.ProfileID)
+	case a.profile.ProfileCRN != "":
+		form.Set("profile_crn", a.profile.ProfileCRN)
+	default:
+		form.Set("profile_name", a.profile.ProfileName)
+		form.Set("account", a.profile.AccountID)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, a.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	response, err := a.client.Do(request)
+	if err != nil {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: %s", a.profile, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: %s", a.profile, err)
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: %d %s", a.profile, response.StatusCode, body)
+	}
+
+	var tokenResponse assumeTokenResponse
+	if err := json.Unmarshal(body, &tokenResponse); err != nil || tokenResponse.AccessToken == "" {
+		return "", 0, fmt.Errorf("[ERROR] Error assuming the trusted profile %s: the response does not have an access token", a.profile)
+	}
+	expiresIn := time.Duration(tokenResponse.ExpiresIn) * time.Second
+	if expiresIn <= 0 && tokenResponse.Expiration > 0 {
+		expiresIn = time.Until(time.Unix(tokenResponse.Expiration, 0))
+	}
+	return tokenResponse.AccessToken, expiresIn, nil
+}
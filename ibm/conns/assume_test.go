@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAssumeTrustedProfileValidate(t *testing.T) {
+	for _, test := range []struct {
+		profile AssumeTrustedProfile
+		valid   bool
+	}{
+		{AssumeTrustedProfile{ProfileID: "profile1"}, true},
+		{AssumeTrustedProfile{ProfileCRN: "crn:v1:bluemix:public:iam-identity::a/account1::profile:profile1"}, true},
+		{AssumeTrustedProfile{ProfileName: "profile1", AccountID: "account1"}, true},
+		{AssumeTrustedProfile{ProfileName: "profile1"}, false},
+		{AssumeTrustedProfile{ProfileID: "profile1", ProfileName: "profile1", AccountID: "account1"}, false},
+		{AssumeTrustedProfile{}, false},
+	} {
+		if err := test.profile.Validate(); (err == nil) != test.valid {
+			t.Errorf("Expected %+v to be valid %t, got %v", test.profile, test.valid, err)
+		}
+	}
+}
+
+func TestAssumeAuthenticatorChain(t *testing.T) {
+	requests := 0
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		r.ParseForm()
+		if failing || r.URL.Path != "/identity/token" || r.Form.Get("grant_type") != assumeGrantType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// the token of a profile is the token that it was assumed with and the profile
+		var token string
+		switch {
+		case r.Form.Get("access_token") == "token0" && r.Form.Get("profile_id") == "profile1":
+			token = "token1"
+		case r.Form.Get("access_token") == "token1" && r.Form.Get("profile_name") == "profile2" && r.Form.Get("account") == "account2":
+			token = "token2"
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": token, "expires_in": 3600})
+	}))
+	defer server.Close()
+
+	authenticator, err := newAssumeAuthenticator(&core.BearerTokenAuthenticator{BearerToken: "token0"}, []AssumeTrustedProfile{
+		{ProfileID: "profile1"},
+		{ProfileName: "profile2", AccountID: "account2"},
+	}, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticator.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	request := &http.Request{Header: make(http.Header)}
+	if err := authenticator.Authenticate(request); err != nil {
+		t.Fatal(err)
+	}
+	if authorization := request.Header.Get("Authorization"); authorization != "Bearer token2" {
+		t.Errorf("Expected the token of the last profile, got %s", authorization)
+	}
+	if requests != 2 {
+		t.Errorf("Expected a token request per profile, got %d", requests)
+	}
+
+	// the tokens are reused until they are about to expire
+	authenticator.Authenticate(&http.Request{Header: make(http.Header)})
+	if requests != 2 {
+		t.Errorf("Expected the tokens to be reused, got %d requests", requests)
+	}
+	last := authenticator.(*assumeAuthenticator)
+	last.refreshAt = time.Now().Add(-time.Second)
+	authenticator.Authenticate(&http.Request{Header: make(http.Header)})
+	if requests != 3 {
+		t.Errorf("Expected the token of the last profile to be refreshed, got %d requests", requests)
+	}
+
+	// the token is still used while it is valid when it cannot be refreshed
+	failing = true
+	last.refreshAt = time.Now().Add(-time.Second)
+	if err := authenticator.Authenticate(&http.Request{Header: make(http.Header)}); err != nil {
+		t.Errorf("Expected the valid token to be used, got %s", err)
+	}
+	last.expiration = time.Now().Add(-time.Second)
+	if err := authenticator.Authenticate(&http.Request{Header: make(http.Header)}); err == nil {
+		t.Errorf("Expected an error when the token expired and cannot be refreshed")
+	}
+}
+
+func TestNewAssumeAuthenticatorErrors(t *testing.T) {
+	if _, err := newAssumeAuthenticator(nil, []AssumeTrustedProfile{{ProfileID: "profile1"}}, "https://iam.cloud.ibm.com", nil); err == nil {
+		t.Errorf("Expected an error without a base credential")
+	}
+	if _, err := newAssumeAuthenticator(&core.BearerTokenAuthenticator{BearerToken: "token0"}, []AssumeTrustedProfile{{ProfileName: "profile1"}}, "https://iam.cloud.ibm.com", nil); err == nil {
+		t.Errorf("Expected an error with an invalid profile")
+	}
+}
@@ -82,18 +82,10 @@ func SetupOpenWhiskClientConfig(namespace string, sess *bxsession.Session, funct
 			if n.IsIamEnabled() {
 				additionalHeaders := make(http.Header)
 
+				// the token request is retried by the client of the authenticator
 				err := RefreshToken(sess)
 				if err != nil {
-					for count := sess.Config.MaxRetries; *count >= 0; *count-- {
-						if err == nil || !isRetryable(err) {
-							break
-						}
-						err = RefreshToken(sess)
-					}
-					if err != nil {
-						return nil, err
-					}
-
+					return nil, err
 				}
 				additionalHeaders.Add("Authorization", sess.Config.IAMAccessToken)
 				additionalHeaders.Add("X-Namespace-Id", n.GetID())
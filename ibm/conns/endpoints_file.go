@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/IBM-Cloud/bluemix-go/endpoints"
+)
+
+// EndpointsFileDefaultRegion is the region of the endpoints file used when the endpoints of a service
+// do not list the region of the provider.
+const EndpointsFileDefaultRegion = "default"
+
+// endpointsFileServices are the keys of the services whose endpoints are read from the endpoints file.
+var endpointsFileServices = []string{
+	"IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APPID_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_APP_CONFIG_ENDPOINT",
+	"IBMCLOUD_ATRACKER_API_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_CONNECTOR_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_ENDPOINT",
+	"IBMCLOUD_BACKUP_RECOVERY_MANAGER_ENDPOINT",
+	"IBMCLOUD_CATALOG_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT",
+	"IBMCLOUD_CIS_API_ENDPOINT",
+	"IBMCLOUD_CLOUD_SHELL_API_ENDPOINT",
+	"IBMCLOUD_CODE_ENGINE_API_ENDPOINT",
+	"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT",
+	"IBMCLOUD_COS_CONFIG_ENDPOINT",
+	"IBMCLOUD_COS_ENDPOINT",
+	"IBMCLOUD_CR_API_ENDPOINT",
+	"IBMCLOUD_CSE_ENDPOINT",
+	"IBMCLOUD_CS_API_ENDPOINT",
+	"IBMCLOUD_DL_API_ENDPOINT",
+	"IBMCLOUD_DL_PROVIDER_API_ENDPOINT",
+	"IBMCLOUD_ENTERPRISE_API_ENDPOINT",
+	"IBMCLOUD_EVENT_NOTIFICATIONS_API_ENDPOINT",
+	"IBMCLOUD_FUNCTIONS_API_ENDPOINT",
+	"IBMCLOUD_GS_API_ENDPOINT",
+	"IBMCLOUD_GT_API_ENDPOINT",
+	"IBMCLOUD_HPCS_API_ENDPOINT",
+	"IBMCLOUD_IAMPAP_API_ENDPOINT",
+	"IBMCLOUD_IAM_API_ENDPOINT",
+	"IBMCLOUD_ICD_API_ENDPOINT",
+	"IBMCLOUD_IS_NG_API_ENDPOINT",
+	"IBMCLOUD_KP_API_ENDPOINT",
+	"IBMCLOUD_LOGS_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_LOGS_ROUTING_API_ENDPOINT_V3",
+	"IBMCLOUD_MCCP_API_ENDPOINT",
+	"IBMCLOUD_METRICS_ROUTING_API_ENDPOINT",
+	"IBMCLOUD_MQCLOUD_CONFIG_ENDPOINT",
+	"IBMCLOUD_PARTNER_CENTER_SELL_API_ENDPOINT",
+	"IBMCLOUD_PRIVATE_DNS_API_ENDPOINT",
+	"IBMCLOUD_PROJECT_API_ENDPOINT",
+	"IBMCLOUD_PUSH_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT",
+	"IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_API_ENDPOINT",
+	"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT",
+	"IBMCLOUD_SAT_API_ENDPOINT",
+	"IBMCLOUD_SCHEMATICS_API_ENDPOINT",
+	"IBMCLOUD_TEKTON_PIPELINE_ENDPOINT",
+	"IBMCLOUD_TG_API_ENDPOINT",
+	"IBMCLOUD_TOOLCHAIN_ENDPOINT",
+	"IBMCLOUD_UAA_ENDPOINT",
+	"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT",
+	"IBMCLOUD_USER_MANAGEMENT_ENDPOINT",
+}
+
+// endpointsFileSettings are the keys of the endpoints file whose values are not endpoints.
+var endpointsFileSettings = []string{
+	"IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY",
+}
+
+var endpointsFileVisibilities = []string{"public", "private", "public-and-private"}
+
+// EndpointsFileServices returns the keys of the services whose endpoints are read from the endpoints file.
+func EndpointsFileServices() []string {
+	return append([]string(nil), endpointsFileServices...)
+}
+
+// endpointsFile holds the content of an endpoints file: the endpoints of each service by visibility and region.
+type endpointsFile map[string]map[string]map[string]string
+
+// endpointsFiles caches the endpoints files by path, so that each file is parsed once.
+var endpointsFiles sync.Map
+
+// loadEndpointsFile reads and parses the endpoints file at path.
+func loadEndpointsFile(path string) (endpointsFile, error) {
+	if cached, ok := endpointsFiles.Load(path); ok {
+		return cached.(endpointsFile), nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to read the endpoints file %s: %s", path, err)
+	}
+	fileMap := endpointsFile{}
+	if err := json.Unmarshal(content, &fileMap); err != nil {
+		return nil, fmt.Errorf("[ERROR] Unable to parse the endpoints file %s, expected the endpoints of each service by visibility and region: %s", path, err)
+	}
+
+	endpointsFiles.Store(path, fileMap)
+	return fileMap, nil
+}
+
+// endpointsFilePath returns the path of the endpoints file set in the configuration or in the environment.
+func endpointsFilePath(path string) string {
+	return EnvFallBack([]string{"IBMCLOUD_ENDPOINTS_FILE_PATH", "IC_ENDPOINTS_FILE_PATH"}, path)
+}
+
+// EndpointsFileProblem is a problem found in the endpoints file of the provider.
+type EndpointsFileProblem struct {
+	// Warning is set when the problem does not prevent the endpoints file from being used.
+	Warning bool
+	Summary string
+	Detail  string
+}
+
+// ValidateEndpointsFile validates the endpoints file of the configuration. The file must be valid JSON
+// with the endpoints of each service by visibility and region, and the endpoints must be HTTP URLs.
+// Unknown services and services without an endpoint for the region of the provider are reported as warnings.
+func (c *Config) ValidateEndpointsFile() []EndpointsFileProblem {
+	path := endpointsFilePath(c.EndpointsFile)
+	if path == "" {
+		return nil
+	}
+	fileMap, err := loadEndpointsFile(path)
+	if err != nil {
+		return []EndpointsFileProblem{{
+			Summary: "Invalid endpoints file",
+			Detail:  err.Error(),
+		}}
+	}
+	return fileMap.validate(path, c.Region, c.Visibility)
+}
+
+func (f endpointsFile) validate(path, region, visibility string) []EndpointsFileProblem {
+	services := map[string]bool{}
+	for _, key := range endpointsFileServices {
+		services[key] = true
+	}
+	settings := map[string]bool{}
+	for _, key := range endpointsFileSettings {
+		settings[key] = true
+	}
+	visibilities := map[string]bool{}
+	for _, entryVisibility := range endpointsFileVisibilities {
+		visibilities[entryVisibility] = true
+	}
+
+	var problems []EndpointsFileProblem
+	for _, key := range sortedKeys(f) {
+		if !services[key] && !settings[key] {
+			problems = append(problems, EndpointsFileProblem{
+				Warning: true,
+				Summary: "Unknown service in the endpoints file",
+				Detail:  fmt.Sprintf("The endpoints file %s sets the endpoints of %q, which is not a service supported by the endpoints file. The entry is ignored.", path, key),
+			})
+			continue
+		}
+
+		for _, entryVisibility := range sortedKeys(f[key]) {
+			if !visibilities[entryVisibility] {
+				problems = append(problems, EndpointsFileProblem{
+					Summary: "Invalid visibility in the endpoints file",
+					Detail:  fmt.Sprintf("The endpoints file %s sets the endpoints of %q for the visibility %q, expected one of %q.", path, key, entryVisibility, endpointsFileVisibilities),
+				})
+				continue
+			}
+			if settings[key] {
+				continue
+			}
+			for _, entryRegion := range sortedKeys(f[key][entryVisibility]) {
+				endpoint := f[key][entryVisibility][entryRegion]
+				if endpoint == "" {
+					continue
+				}
+				if err := validateEndpointURL(endpoint); err != nil {
+					problems = append(problems, EndpointsFileProblem{
+						Summary: "Invalid endpoint in the endpoints file",
+						Detail:  fmt.Sprintf("The endpoints file %s sets an invalid %s endpoint of %q for the region %q: %s", path, entryVisibility, key, entryRegion, err),
+					})
+				}
+			}
+		}
+
+		if regions, ok := f[key][visibility]; ok && region != "" {
+			_, hasRegion := regions[region]
+			_, hasDefault := regions[EndpointsFileDefaultRegion]
+			if !hasRegion && !hasDefault {
+				problems = append(problems, EndpointsFileProblem{
+					Warning: true,
+					Summary: "Missing region in the endpoints file",
+					Detail: fmt.Sprintf("The endpoints file %s does not set the %s endpoint of %q for the region %q, nor a %q endpoint. The default endpoint of the service is used.",
+						path, visibility, key, region, EndpointsFileDefaultRegion),
+				})
+			}
+		}
+	}
+	return problems
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func validateEndpointURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("%q is not an HTTP or HTTPS URL", endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", endpoint)
+	}
+	return nil
+}
+
+// endpointsFileLocator resolves the endpoints of the bluemix-go clients from the endpoints file of
+// the provider, which supports the default region, before falling back to the bluemix-go locator.
+type endpointsFileLocator struct {
+	endpoints.EndpointLocator
+	file       endpointsFile
+	region     string
+	visibility string
+}
+
+func newEndpointsFileLocator(file endpointsFile, path, region, visibility string) endpoints.EndpointLocator {
+	if visibility == "" {
+		visibility = EnvFallBack([]string{"IC_VISIBILITY", "IBMCLOUD_VISIBILITY"}, "public")
+	}
+	return &endpointsFileLocator{
+		EndpointLocator: endpoints.NewEndpointLocator(region, visibility, path),
+		file:            file,
+		region:          region,
+		visibility:      visibility,
+	}
+}
+
+// endpoint returns the endpoint of the service from the endpoints file, unless the environment sets it
+// or the file does not. Otherwise it returns the endpoint of the bluemix-go locator.
+func (l *endpointsFileLocator) endpoint(key string, locate func() (string, error)) (string, error) {
+	if os.Getenv(key) == "" && l.visibility != "public-and-private" {
+		if endpoint := fileFallBack(l.file, l.visibility, key, l.region, ""); endpoint != "" {
+			return endpoint, nil
+		}
+	}
+	return locate()
+}
+
+func (l *endpointsFileLocator) AccountManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ACCOUNT_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.AccountManagementEndpoint)
+}
+
+func (l *endpointsFileLocator) CertificateManagerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CERTIFICATE_MANAGER_API_ENDPOINT", l.EndpointLocator.CertificateManagerEndpoint)
+}
+
+func (l *endpointsFileLocator) ContainerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CS_API_ENDPOINT", l.EndpointLocator.ContainerEndpoint)
+}
+
+func (l *endpointsFileLocator) ContainerRegistryEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CR_API_ENDPOINT", l.EndpointLocator.ContainerRegistryEndpoint)
+}
+
+func (l *endpointsFileLocator) CisEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CIS_API_ENDPOINT", l.EndpointLocator.CisEndpoint)
+}
+
+func (l *endpointsFileLocator) GlobalSearchEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GS_API_ENDPOINT", l.EndpointLocator.GlobalSearchEndpoint)
+}
+
+func (l *endpointsFileLocator) GlobalTaggingEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_GT_API_ENDPOINT", l.EndpointLocator.GlobalTaggingEndpoint)
+}
+
+func (l *endpointsFileLocator) IAMEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAM_API_ENDPOINT", l.EndpointLocator.IAMEndpoint)
+}
+
+func (l *endpointsFileLocator) IAMPAPEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_IAMPAP_API_ENDPOINT", l.EndpointLocator.IAMPAPEndpoint)
+}
+
+func (l *endpointsFileLocator) ICDEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_ICD_API_ENDPOINT", l.EndpointLocator.ICDEndpoint)
+}
+
+func (l *endpointsFileLocator) MCCPAPIEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_MCCP_API_ENDPOINT", l.EndpointLocator.MCCPAPIEndpoint)
+}
+
+func (l *endpointsFileLocator) ResourceManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_MANAGEMENT_API_ENDPOINT", l.EndpointLocator.ResourceManagementEndpoint)
+}
+
+func (l *endpointsFileLocator) ResourceControllerEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT", l.EndpointLocator.ResourceControllerEndpoint)
+}
+
+func (l *endpointsFileLocator) ResourceCatalogEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT", l.EndpointLocator.ResourceCatalogEndpoint)
+}
+
+func (l *endpointsFileLocator) UAAEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_UAA_ENDPOINT", l.EndpointLocator.UAAEndpoint)
+}
+
+func (l *endpointsFileLocator) CseEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_CSE_ENDPOINT", l.EndpointLocator.CseEndpoint)
+}
+
+func (l *endpointsFileLocator) SchematicsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SCHEMATICS_API_ENDPOINT", l.EndpointLocator.SchematicsEndpoint)
+}
+
+func (l *endpointsFileLocator) UserManagementEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_USER_MANAGEMENT_ENDPOINT", l.EndpointLocator.UserManagementEndpoint)
+}
+
+func (l *endpointsFileLocator) HpcsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_HPCS_API_ENDPOINT", l.EndpointLocator.HpcsEndpoint)
+}
+
+func (l *endpointsFileLocator) FunctionsEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_FUNCTIONS_API_ENDPOINT", l.EndpointLocator.FunctionsEndpoint)
+}
+
+func (l *endpointsFileLocator) SatelliteEndpoint() (string, error) {
+	return l.endpoint("IBMCLOUD_SAT_API_ENDPOINT", l.EndpointLocator.SatelliteEndpoint)
+}
+
+// FileFallBack returns the endpoint of the service from the endpoints file, or the default value when
+// the file does not set it. A file that cannot be read is logged and ignored; it is reported when the
+// provider is configured.
+func FileFallBack(endpointsFile, visibility, key, region, defaultValue string) string {
+	path := endpointsFilePath(endpointsFile)
+	if path == "" {
+		return defaultValue
+	}
+	fileMap, err := loadEndpointsFile(path)
+	if err != nil {
+		log.Printf("%s", err)
+		return defaultValue
+	}
+	return fileFallBack(fileMap, visibility, key, region, defaultValue)
+}
+
+// fileFallBack returns the endpoint of the service for the region, or for the default region of the
+// endpoints file, or the default value.
+func fileFallBack(fileMap endpointsFile, visibility, key, region, defaultValue string) string {
+	regions := fileMap[key][visibility]
+	if endpoint := regions[region]; endpoint != "" {
+		return endpoint
+	}
+	if endpoint := regions[EndpointsFileDefaultRegion]; endpoint != "" {
+		return endpoint
+	}
+	return defaultValue
+}
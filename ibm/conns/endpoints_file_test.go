@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestEndpointsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing the endpoints file: %s", err)
+	}
+	return path
+}
+
+func TestEndpointsFileInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"malformed":  `{"IBMCLOUD_IS_NG_API_ENDPOINT": {`,
+		"wrong type": `{"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": 1}}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := &Config{EndpointsFile: writeTestEndpointsFile(t, content), Region: "us-south", Visibility: "public"}
+
+			problems := config.ValidateEndpointsFile()
+			if len(problems) != 1 || problems[0].Warning {
+				t.Fatalf("Expected an error, got %+v", problems)
+			}
+			if _, err := config.ClientSession(); err == nil {
+				t.Fatalf("Expected the client session to fail")
+			}
+		})
+	}
+
+	config := &Config{EndpointsFile: filepath.Join(t.TempDir(), "missing.json")}
+	if problems := config.ValidateEndpointsFile(); len(problems) != 1 || problems[0].Warning {
+		t.Fatalf("Expected an error for a missing file, got %+v", problems)
+	}
+}
+
+func TestEndpointsFileValidate(t *testing.T) {
+	path := writeTestEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {"public": {"us-south": "https://us-south.iaas.example.com/v1"}},
+		"IBMCLOUD_IS_NG_API_ENDPINT": {"public": {"us-south": "https://us-south.iaas.example.com/v1"}},
+		"IBMCLOUD_IAM_API_ENDPOINT": {"public": {"eu-de": "iam.example.com"}},
+		"IBMCLOUD_TG_API_ENDPOINT": {"public": {"default": "https://tg.example.com"}},
+		"IBMCLOUD_DL_API_ENDPOINT": {"internal": {"us-south": "https://dl.example.com"}}
+	}`)
+	config := &Config{EndpointsFile: path, Region: "us-south", Visibility: "public"}
+
+	var errs, warnings []string
+	for _, problem := range config.ValidateEndpointsFile() {
+		if problem.Warning {
+			warnings = append(warnings, problem.Detail)
+		} else {
+			errs = append(errs, problem.Detail)
+		}
+	}
+
+	if len(errs) != 2 || !strings.Contains(errs[0], `"internal"`) || !strings.Contains(errs[1], `"iam.example.com"`) {
+		t.Errorf("Expected an invalid visibility and an invalid URL, got %q", errs)
+	}
+	if len(warnings) != 2 || !strings.Contains(warnings[0], `"IBMCLOUD_IAM_API_ENDPOINT" for the region "us-south"`) || !strings.Contains(warnings[1], `"IBMCLOUD_IS_NG_API_ENDPINT"`) {
+		t.Errorf("Expected a missing region and an unknown service, got %q", warnings)
+	}
+}
+
+func TestFileFallBackDefaultRegion(t *testing.T) {
+	path := writeTestEndpointsFile(t, `{
+		"IBMCLOUD_IS_NG_API_ENDPOINT": {
+			"private": {
+				"us-south": "https://us-south.private.iaas.example.com/v1",
+				"default": "https://private.iaas.example.com/v1"
+			}
+		}
+	}`)
+
+	tests := []struct {
+		visibility, region, expected string
+	}{
+		{"private", "us-south", "https://us-south.private.iaas.example.com/v1"},
+		{"private", "eu-de", "https://private.iaas.example.com/v1"},
+		{"public", "eu-de", "https://fallback.example.com"},
+	}
+	for _, test := range tests {
+		if endpoint := FileFallBack(path, test.visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", test.region, "https://fallback.example.com"); endpoint != test.expected {
+			t.Errorf("Expected %s for %s %s, got %s", test.expected, test.visibility, test.region, endpoint)
+		}
+	}
+
+	if endpoint := FileFallBack(filepath.Join(t.TempDir(), "missing.json"), "public", "IBMCLOUD_IS_NG_API_ENDPOINT", "us-south", "https://fallback.example.com"); endpoint != "https://fallback.example.com" {
+		t.Errorf("Expected the default endpoint when the file cannot be read, got %s", endpoint)
+	}
+}
+
+func TestEndpointsFileLocator(t *testing.T) {
+	path := writeTestEndpointsFile(t, `{"IBMCLOUD_CS_API_ENDPOINT": {"private": {"default": "https://containers.example.com"}}}`)
+	fileMap, err := loadEndpointsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locator := newEndpointsFileLocator(fileMap, path, "eu-de", "private")
+	if endpoint, _ := locator.ContainerEndpoint(); endpoint != "https://containers.example.com" {
+		t.Errorf("Expected the default endpoint of the file, got %s", endpoint)
+	}
+
+	t.Setenv("IBMCLOUD_CS_API_ENDPOINT", "https://env.example.com")
+	if endpoint, _ := locator.ContainerEndpoint(); endpoint != "https://env.example.com" {
+		t.Errorf("Expected the endpoint of the environment, got %s", endpoint)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"log"
+	"sync"
+)
+
+// lazyClients defers the construction of the service clients of a session until they are used.
+// Each client is built by an initializer registered while the session is configured. The
+// initializer runs once, the first time one of the accessors of the client is called, and the
+// client and error it sets on the session are shared by all the later calls.
+type lazyClients struct {
+	initializers map[string]*lazyInitializer
+}
+
+type lazyInitializer struct {
+	once sync.Once
+	init func()
+}
+
+func newLazyClients() *lazyClients {
+	return &lazyClients{
+		initializers: make(map[string]*lazyInitializer),
+	}
+}
+
+// register sets the initializer of the named client. All the initializers must be registered
+// before the session is used.
+func (l *lazyClients) register(name string, init func()) {
+	l.initializers[name] = &lazyInitializer{init: init}
+}
+
+// load builds the named client, unless it is already built. It does nothing when the client has
+// no initializer, such as when the session is configured without IBM Cloud credentials.
+func (l *lazyClients) load(name string) {
+	if l == nil {
+		return
+	}
+	initializer, ok := l.initializers[name]
+	if !ok {
+		return
+	}
+	initializer.once.Do(func() {
+		log.Printf("[DEBUG] Configuring the %s client", name)
+		initializer.init()
+		initializer.init = nil
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyClientsLoadOnce(t *testing.T) {
+	clients := newLazyClients()
+
+	var calls int32
+	clients.register("vpc", func() {
+		atomic.AddInt32(&calls, 1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clients.load("vpc")
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected the initializer to run once, ran %d times", calls)
+	}
+}
+
+func TestLazyClientsLoadUnregistered(t *testing.T) {
+	clients := newLazyClients()
+	clients.load("vpc")
+
+	var nilClients *lazyClients
+	nilClients.load("vpc")
+}
+
+func TestClientSessionWithoutCredentials(t *testing.T) {
+	sess := &clientSession{
+		vpcErr: errEmptyBluemixCredentials,
+	}
+	if _, err := sess.VpcV1API(); err != errEmptyBluemixCredentials {
+		t.Fatalf("Expected %v, got %v", errEmptyBluemixCredentials, err)
+	}
+}
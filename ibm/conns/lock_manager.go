@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// DefaultLockHoldWarning is the duration after which a held lock is logged as possibly stuck
+const DefaultLockHoldWarning = 10 * time.Minute
+
+// IbmLockManager is the lock manager that the resources of the provider serialize their changes with
+var IbmLockManager = NewLockManager()
+
+// LockManager is a store of read/write locks by key, which lets resources serialize their changes
+// of a shared object, such as the rules of a security group. Unlike MutexKV, waiting for a lock
+// ends with the context of the caller, and the wait and hold times of the locks are logged.
+type LockManager struct {
+	// HoldWarning is the duration after which a held lock is logged as possibly stuck, every time
+	// that it elapses. The lock is not logged when it is zero.
+	HoldWarning time.Duration
+
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+// keyLock is the state of the lock of a key, which is guarded by the mutex of its manager
+type keyLock struct {
+	writer         bool
+	readers        int
+	waiters        int
+	waitingWriters int
+
+	// released is closed and replaced every time that the lock is released
+	released chan struct{}
+
+	heldSince time.Time
+	warning   *time.Timer
+}
+
+// NewLockManager returns a lock manager with the default hold warning
+func NewLockManager() *LockManager {
+	return &LockManager{
+		HoldWarning: DefaultLockHoldWarning,
+		locks:       make(map[string]*keyLock),
+	}
+}
+
+// LockContext locks the key for writing, waiting for the other holders until the context ends. The
+// caller must call Unlock for the key when it returns no error.
+func (m *LockManager) LockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, true)
+}
+
+// RLockContext locks the key for reading, along with the other readers, waiting for the writer until
+// the context ends. The caller must call RUnlock for the key when it returns no error.
+func (m *LockManager) RLockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, false)
+}
+
+// LockTimeout locks the key for writing like LockContext, waiting up to the timeout, for the
+// functions of the resources that do not have a context
+func (m *LockManager) LockTimeout(key string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return m.acquire(ctx, key, true)
+}
+
+// RLockTimeout locks the key for reading like RLockContext, waiting up to the timeout
+func (m *LockManager) RLockTimeout(key string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return m.acquire(ctx, key, false)
+}
+
+// Lock locks the key for writing, waiting for the other holders without a limit
+func (m *LockManager) Lock(key string) {
+	m.acquire(context.Background(), key, true)
+}
+
+// Unlock releases the key locked for writing
+func (m *LockManager) Unlock(key string) {
+	m.release(key, true)
+}
+
+// RUnlock releases the key locked for reading
+func (m *LockManager) RUnlock(key string) {
+	m.release(key, false)
+}
+
+func (m *LockManager) acquire(ctx context.Context, key string, write bool) error {
+	mode := lockMode(write)
+	start := time.Now()
+	log.Printf("[DEBUG] Locking %q for %s", key, mode)
+
+	m.mu.Lock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyLock{released: make(chan struct{})}
+		m.locks[key] = lock
+	}
+	lock.waiters++
+	if write {
+		lock.waitingWriters++
+	}
+	for !lock.available(write) {
+		released := lock.released
+		m.mu.Unlock()
+		select {
+		case <-released:
+			m.mu.Lock()
+		case <-ctx.Done():
+			m.mu.Lock()
+			lock.waiters--
+			if write {
+				// the readers that waited for this writer may take the lock
+				lock.waitingWriters--
+				lock.broadcast()
+			}
+			m.remove(key, lock)
+			m.mu.Unlock()
+			return fmt.Errorf("[ERROR] Error locking %q for %s after waiting %s: %s", key, mode, time.Since(start).Round(time.Millisecond), ctx.Err())
+		}
+	}
+	lock.waiters--
+	if write {
+		lock.waitingWriters--
+		lock.writer = true
+	} else {
+		lock.readers++
+	}
+	if lock.heldSince.IsZero() {
+		lock.heldSince = time.Now()
+		if m.HoldWarning > 0 {
+			heldSince := lock.heldSince
+			lock.warning = time.AfterFunc(m.HoldWarning, func() { m.warn(key, lock, heldSince) })
+		}
+	}
+	m.mu.Unlock()
+
+	log.Printf("[DEBUG] Locked %q for %s after waiting %s", key, mode, time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+func (m *LockManager) release(key string, write bool) {
+	mode := lockMode(write)
+	log.Printf("[DEBUG] Unlocking %q for %s", key, mode)
+
+	m.mu.Lock()
+	lock, ok := m.locks[key]
+	if !ok || (write && !lock.writer) || (!write && lock.readers == 0) {
+		m.mu.Unlock()
+		panic(fmt.Sprintf("unlock of the unlocked key %q for %s", key, mode))
+	}
+	if write {
+		lock.writer = false
+	} else {
+		lock.readers--
+	}
+	var held time.Duration
+	if !lock.writer && lock.readers == 0 {
+		held = time.Since(lock.heldSince)
+		lock.heldSince = time.Time{}
+		if lock.warning != nil {
+			lock.warning.Stop()
+			lock.warning = nil
+		}
+	}
+	lock.broadcast()
+	m.remove(key, lock)
+	m.mu.Unlock()
+
+	if held > 0 {
+		log.Printf("[DEBUG] Unlocked %q after holding it for %s", key, held.Round(time.Millisecond))
+	} else {
+		log.Printf("[DEBUG] Unlocked %q for %s", key, mode)
+	}
+}
+
+// warn logs the lock of the key while it is held since the same time, and again after every hold
+// warning
+func (m *LockManager) warn(key string, lock *keyLock, heldSince time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.locks[key] != lock || !lock.heldSince.Equal(heldSince) {
+		return
+	}
+	log.Printf("[WARN] %q has been locked for %s by %s, and %d waiting for it. A resource may be stuck while holding it.",
+		key, time.Since(heldSince).Round(time.Second), lock.holders(), lock.waiters)
+	if lock.warning != nil && m.HoldWarning > 0 {
+		lock.warning.Reset(m.HoldWarning)
+	}
+}
+
+// remove removes the lock of the key once it is neither held nor waited for. The mutex of the
+// manager must be held.
+func (m *LockManager) remove(key string, lock *keyLock) {
+	if !lock.writer && lock.readers == 0 && lock.waiters == 0 {
+		delete(m.locks, key)
+	}
+}
+
+// available returns whether the lock can be taken, where the writers that wait for the lock take it
+// before the new readers
+func (l *keyLock) available(write bool) bool {
+	if write {
+		return !l.writer && l.readers == 0
+	}
+	return !l.writer && l.waitingWriters == 0
+}
+
+// broadcast wakes up the callers that wait for the lock
+func (l *keyLock) broadcast() {
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+func (l *keyLock) holders() string {
+	if l.writer {
+		return "a writer"
+	}
+	return fmt.Sprintf("%d readers", l.readers)
+}
+
+func lockMode(write bool) string {
+	if write {
+		return "writing"
+	}
+	return "reading"
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// acquired returns whether the lock function returns within a short time
+func acquired(lock func() error) bool {
+	done := make(chan error, 1)
+	go func() { done <- lock() }()
+	select {
+	case err := <-done:
+		return err == nil
+	case <-time.After(50 * time.Millisecond):
+		return false
+	}
+}
+
+func TestLockManagerLockContext(t *testing.T) {
+	m := NewLockManager()
+	if err := m.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if !acquired(func() error { return m.RLockContext(context.Background(), "bar") }) {
+		t.Errorf("Expected a different key not to be locked")
+	}
+
+	// the wait ends with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := m.LockContext(ctx, "foo")
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Errorf("Expected the lock to time out, got %v", err)
+	}
+	if err := m.LockTimeout("foo", 10*time.Millisecond); err == nil {
+		t.Errorf("Expected the lock to time out")
+	}
+
+	m.Unlock("foo")
+	if !acquired(func() error { return m.LockContext(context.Background(), "foo") }) {
+		t.Errorf("Expected the key to be locked after it was unlocked")
+	}
+	m.Unlock("foo")
+	m.RUnlock("bar")
+	if len(m.locks) != 0 {
+		t.Errorf("Expected the locks to be removed once they are released, got %d", len(m.locks))
+	}
+}
+
+func TestLockManagerReadWrite(t *testing.T) {
+	m := NewLockManager()
+	m.RLockContext(context.Background(), "foo")
+	if !acquired(func() error { return m.RLockTimeout("foo", time.Second) }) {
+		t.Errorf("Expected the readers to hold the key together")
+	}
+
+	// the writer waits for the readers, and the new readers wait for the writer
+	writer := make(chan error, 1)
+	go func() { writer <- m.LockContext(context.Background(), "foo") }()
+	time.Sleep(10 * time.Millisecond)
+	if acquired(func() error { return m.RLockTimeout("foo", 20*time.Millisecond) }) {
+		t.Errorf("Expected a new reader to wait for the waiting writer")
+	}
+	m.RUnlock("foo")
+	select {
+	case <-writer:
+		t.Fatal("Expected the writer to wait for the last reader")
+	case <-time.After(20 * time.Millisecond):
+	}
+	m.RUnlock("foo")
+	if err := <-writer; err != nil {
+		t.Fatal(err)
+	}
+	m.Unlock("foo")
+
+	// a writer that stops waiting lets the readers in
+	m.RLockContext(context.Background(), "foo")
+	m.LockTimeout("foo", 10*time.Millisecond)
+	if !acquired(func() error { return m.RLockContext(context.Background(), "foo") }) {
+		t.Errorf("Expected a reader to lock the key after the writer stopped waiting")
+	}
+}
+
+func TestLockManagerUnlockUnlocked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a panic when an unlocked key is unlocked")
+		}
+	}()
+	NewLockManager().Unlock("foo")
+}
+
+func TestLockManagerHoldWarning(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	m := NewLockManager()
+	m.HoldWarning = 10 * time.Millisecond
+	m.Lock("foo")
+	time.Sleep(35 * time.Millisecond)
+	m.Unlock("foo")
+	time.Sleep(20 * time.Millisecond)
+
+	output := buf.String()
+	if warnings := strings.Count(output, `[WARN] "foo" has been locked`); warnings < 2 || warnings > 4 {
+		t.Errorf("Expected a warning every time that the hold warning elapsed, got %d in %s", warnings, output)
+	}
+	if !strings.Contains(output, `[DEBUG] Locked "foo" for writing after waiting`) || !strings.Contains(output, `[DEBUG] Unlocked "foo" after holding it for`) {
+		t.Errorf("Expected the wait and hold times to be logged, got %s", output)
+	}
+}
@@ -17,10 +17,9 @@ import (
 //
 // The initial use case is to let aws_security_group_rule resources serialize
 // their access to individual security groups based on SG ID.
-
-// This is a global MutexKV for use within this plugin.
-var IbmMutexKV = NewMutexKV()
-
+//
+// Deprecated: Use LockManager and IbmLockManager, whose locks wait until the
+// context of the resource ends.
 type MutexKV struct {
 	lock  sync.Mutex
 	store map[string]*sync.Mutex
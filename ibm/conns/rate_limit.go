@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+// The services whose API calls are rate limited
+const (
+	RateLimitVPC                = "vpc"
+	RateLimitCIS                = "cis"
+	RateLimitIAM                = "iam"
+	RateLimitGlobalTagging      = "global_tagging"
+	RateLimitResourceController = "resource_controller"
+	RateLimitPower              = "power"
+)
+
+// RateLimitServiceNames are the names of the services whose API calls are rate limited
+var RateLimitServiceNames = map[string]string{
+	RateLimitVPC:                "VPC Infrastructure",
+	RateLimitCIS:                "Cloud Internet Services",
+	RateLimitIAM:                "IAM",
+	RateLimitGlobalTagging:      "Global Tagging",
+	RateLimitResourceController: "Resource Controller",
+	RateLimitPower:              "Power Virtual Server",
+}
+
+// RateLimit limits the API calls of a service made by a provider configuration
+type RateLimit struct {
+	// RequestsPerSecond is the rate of the API calls, which is not limited when it is zero
+	RequestsPerSecond float64
+	// Burst is the number of API calls that are made at once before they are limited to the rate
+	Burst int
+	// MaxConcurrentRequests is the maximum number of API calls in flight, which is not limited when
+	// it is zero
+	MaxConcurrentRequests int
+}
+
+// DefaultRateLimits are the rate limits of the services, which stay below the limits of the APIs
+var DefaultRateLimits = map[string]RateLimit{
+	RateLimitVPC:                {RequestsPerSecond: 20, Burst: 40},
+	RateLimitCIS:                {RequestsPerSecond: 4, Burst: 10},
+	RateLimitIAM:                {RequestsPerSecond: 10, Burst: 20},
+	RateLimitGlobalTagging:      {RequestsPerSecond: 5, Burst: 10},
+	RateLimitResourceController: {RequestsPerSecond: 10, Burst: 20},
+	RateLimitPower:              {RequestsPerSecond: 10, Burst: 20},
+}
+
+// rateLimits returns the rate limits of the services, where the ones of the config replace the
+// defaults
+func (c *Config) rateLimits() map[string]RateLimit {
+	limits := make(map[string]RateLimit, len(DefaultRateLimits))
+	for service, limit := range DefaultRateLimits {
+		limits[service] = limit
+	}
+	for service, limit := range c.RateLimits {
+		limits[service] = limit
+	}
+	return limits
+}
+
+// rateLimitedService returns the service of the API host, or an empty string for the hosts that are
+// not rate limited
+func rateLimitedService(host string) string {
+	host = strings.ToLower(host)
+	if !strings.HasSuffix(host, ".cloud.ibm.com") {
+		return ""
+	}
+	switch {
+	case strings.Contains(host, "power-iaas."):
+		return RateLimitPower
+	case strings.HasSuffix(host, "iaas.cloud.ibm.com"):
+		return RateLimitVPC
+	case strings.Contains(host, ".cis.") || strings.HasPrefix(host, "cis."):
+		return RateLimitCIS
+	case strings.HasPrefix(host, "iam.") || strings.Contains(host, ".iam."):
+		return RateLimitIAM
+	case strings.Contains(host, "global-search-tagging."):
+		return RateLimitGlobalTagging
+	case strings.HasPrefix(host, "resource-controller.") || strings.Contains(host, ".resource-controller."):
+		return RateLimitResourceController
+	}
+	return ""
+}
+
+// rateLimiters are the limiters of the services of a provider configuration, which are shared by
+// its clients
+type rateLimiters struct {
+	services map[string]*serviceLimiter
+}
+
+type serviceLimiter struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// newRateLimiters returns the limiters of the rate limits, or nil when no service is limited
+func newRateLimiters(limits map[string]RateLimit) *rateLimiters {
+	limiters := map[string]*serviceLimiter{}
+	for service, limit := range limits {
+		if limit.RequestsPerSecond <= 0 && limit.MaxConcurrentRequests <= 0 {
+			continue
+		}
+		serviceLimiter := &serviceLimiter{}
+		if limit.RequestsPerSecond > 0 {
+			burst := limit.Burst
+			if burst < 1 {
+				burst = 1
+			}
+			serviceLimiter.limiter = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+		}
+		if limit.MaxConcurrentRequests > 0 {
+			serviceLimiter.slots = make(chan struct{}, limit.MaxConcurrentRequests)
+		}
+		limiters[service] = serviceLimiter
+	}
+	if len(limiters) == 0 {
+		return nil
+	}
+	return &rateLimiters{services: limiters}
+}
+
+// RoundTripper returns a round tripper that limits the requests made with the given one
+func (l *rateLimiters) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if l == nil {
+		return next
+	}
+	if transport, ok := next.(*rateLimitTransport); ok && transport.limiters == l {
+		return next
+	}
+	return &rateLimitTransport{next: next, limiters: l}
+}
+
+type rateLimitTransport struct {
+	next     http.RoundTripper
+	limiters *rateLimiters
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	limiter, ok := t.limiters.services[rateLimitedService(req.URL.Hostname())]
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	// the slot of a request is released once its response is received
+	if limiter.slots != nil {
+		select {
+		case limiter.slots <- struct{}{}:
+			defer func() { <-limiter.slots }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+	if limiter.limiter != nil {
+		if err := limiter.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimitedService(t *testing.T) {
+	for host, service := range map[string]string{
+		"us-south.iaas.cloud.ibm.com":                       RateLimitVPC,
+		"us-south.private.iaas.cloud.ibm.com":               RateLimitVPC,
+		"us-south.power-iaas.cloud.ibm.com":                 RateLimitPower,
+		"api.cis.cloud.ibm.com":                             RateLimitCIS,
+		"iam.cloud.ibm.com":                                 RateLimitIAM,
+		"private.us-south.iam.cloud.ibm.com":                RateLimitIAM,
+		"tags.global-search-tagging.cloud.ibm.com":          RateLimitGlobalTagging,
+		"resource-controller.cloud.ibm.com":                 RateLimitResourceController,
+		"private.us-east.resource-controller.cloud.ibm.com": RateLimitResourceController,
+		"containers.cloud.ibm.com":                          "",
+		"iam.example.com":                                   "",
+	} {
+		if actual := rateLimitedService(host); actual != service {
+			t.Errorf("Expected the service of %s to be %q, got %q", host, service, actual)
+		}
+	}
+}
+
+func TestConfigRateLimits(t *testing.T) {
+	limits := (&Config{RateLimits: map[string]RateLimit{
+		RateLimitVPC: {RequestsPerSecond: 1, Burst: 1, MaxConcurrentRequests: 2},
+	}}).rateLimits()
+	if limits[RateLimitVPC] != (RateLimit{RequestsPerSecond: 1, Burst: 1, MaxConcurrentRequests: 2}) {
+		t.Errorf("Expected the configured rate limit of VPC, got %+v", limits[RateLimitVPC])
+	}
+	if limits[RateLimitCIS] != DefaultRateLimits[RateLimitCIS] {
+		t.Errorf("Expected the default rate limit of CIS, got %+v", limits[RateLimitCIS])
+	}
+	if newRateLimiters(map[string]RateLimit{RateLimitVPC: {}}) != nil {
+		t.Errorf("Expected no limiters without any limit")
+	}
+}
+
+// roundTripFunc is a round tripper of a function
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRateLimitTransportRate(t *testing.T) {
+	limiters := newRateLimiters(map[string]RateLimit{RateLimitVPC: {RequestsPerSecond: 20, Burst: 1}})
+	client := &http.Client{Transport: limiters.RoundTripper(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}))}
+
+	// the requests after the burst are made at the rate
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get("https://us-south.iaas.cloud.ibm.com/v1/instances")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected the requests to be limited to the rate, took %s", elapsed)
+	}
+
+	// the requests of the other services are not limited
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get("https://containers.cloud.ibm.com/global/v2/getClusters")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected the requests not to be limited, took %s", elapsed)
+	}
+
+	// the wait ends with the context of the request
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	limiters.services[RateLimitVPC].limiter.SetBurst(0)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	if _, err := client.Do(req); err == nil {
+		t.Errorf("Expected an error when the context ends before the request is allowed")
+	}
+}
+
+func TestRateLimitTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	transport := newRateLimiters(map[string]RateLimit{RateLimitCIS: {MaxConcurrentRequests: 2}}).RoundTripper(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.cis.cloud.ibm.com/v1/zones", nil)
+			if _, err := transport.RoundTrip(req); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxInFlight != 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestClientTransportRateLimits(t *testing.T) {
+	transport, err := (&Config{}).clientTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	limited := transport.RoundTripper(DefaultTransport())
+	if _, ok := limited.(*rateLimitTransport); !ok {
+		t.Fatalf("Expected the requests to be rate limited by default, got %T", limited)
+	}
+	if transport.RoundTripper(limited) != limited {
+		t.Errorf("Expected the rate limits not to be applied twice")
+	}
+
+	transport, _ = (&Config{RateLimits: map[string]RateLimit{
+		RateLimitVPC:                {},
+		RateLimitCIS:                {},
+		RateLimitIAM:                {},
+		RateLimitGlobalTagging:      {},
+		RateLimitResourceController: {},
+		RateLimitPower:              {},
+	}}).clientTransport()
+	if _, ok := transport.RoundTripper(DefaultTransport()).(*http.Transport); !ok {
+		t.Errorf("Expected the requests not to be rate limited without any limit")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultRetryMaxBackoff is the default maximum wait between two attempts of an API call
+const DefaultRetryMaxBackoff = 30 * time.Second
+
+// retryMinBackoff is the wait before the first retry of an API call, which doubles on every retry
+const retryMinBackoff = 1 * time.Second
+
+// RetryPolicy is the retry policy of the API calls made by the service clients
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of an API call, including the first one
+	MaxAttempts int
+	// MaxBackoff is the maximum wait between two attempts
+	MaxBackoff time.Duration
+	// RetryableStatusCodes are the status codes of the responses that are retried. When it is
+	// empty, the responses with the status code 408, 429 or 5xx, except 501, are retried
+	RetryableStatusCodes []int
+	// HonorRetryAfter waits for the duration of the Retry-After header of a response, up to
+	// MaxBackoff, instead of the exponential backoff
+	HonorRetryAfter bool
+}
+
+// retryPolicy returns the retry policy of the config, with the unset values defaulted. The
+// maximum number of attempts defaults to one more than the retry count.
+func (c *Config) retryPolicy() RetryPolicy {
+	policy := c.Retry
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = c.RetryCount + 1
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultRetryMaxBackoff
+	}
+	return policy
+}
+
+// Apply sets the retry policy on the service of an IBM Cloud SDK client
+func (p RetryPolicy) Apply(service *core.BaseService) {
+	if service == nil {
+		return
+	}
+	if p.MaxAttempts <= 1 {
+		service.DisableRetries()
+		return
+	}
+	service.EnableRetries(p.MaxAttempts-1, p.MaxBackoff)
+	if transport, ok := service.Client.Transport.(*retryablehttp.RoundTripper); ok {
+		p.configure(transport.Client)
+	}
+}
+
+// HTTPClient returns a client that makes the requests of the given client with the retry policy
+func (p RetryPolicy) HTTPClient(client *http.Client) *http.Client {
+	if p.MaxAttempts <= 1 {
+		return client
+	}
+	retryableClient := core.NewRetryableClientWithHTTPClient(client)
+	p.configure(retryableClient)
+	return retryableClient.StandardClient()
+}
+
+func (p RetryPolicy) configure(client *retryablehttp.Client) {
+	client.RetryMax = p.MaxAttempts - 1
+	client.RetryWaitMin = retryMinBackoff
+	client.RetryWaitMax = p.MaxBackoff
+	client.CheckRetry = p.checkRetry
+	client.Backoff = p.backoff
+}
+
+func (p RetryPolicy) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		// the errors that cannot be recovered from, such as an untrusted certificate, are not retried
+		return core.IBMCloudSDKRetryPolicy(ctx, resp, err)
+	}
+	return p.retryableStatus(resp.StatusCode), nil
+}
+
+func (p RetryPolicy) retryableStatus(statusCode int) bool {
+	if len(p.RetryableStatusCodes) == 0 {
+		return statusCode == 408 || statusCode == 429 || (statusCode >= 500 && statusCode <= 599 && statusCode != 501)
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if statusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before the attempt that follows the given attempt. The wait doubles on
+// every attempt up to max, with a random jitter of up to half of the wait.
+func (p RetryPolicy) backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if p.HonorRetryAfter && resp != nil {
+		if wait, ok := retryAfter(resp); ok {
+			if wait > max {
+				return max
+			}
+			return wait
+		}
+	}
+
+	wait := max
+	if attemptNum < 32 {
+		if exponential := min << uint(attemptNum); exponential > 0 && exponential < max {
+			wait = exponential
+		}
+	}
+	jitter := wait / 2
+	return wait - jitter + time.Duration(rand.Int63n(int64(jitter)+1))
+}
+
+// retryAfter returns the wait of the Retry-After header of the response
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
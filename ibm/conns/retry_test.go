@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/IBM/go-sdk-core/v5/core"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	policy := (&Config{RetryCount: 10}).retryPolicy()
+	if policy.MaxAttempts != 11 || policy.MaxBackoff != DefaultRetryMaxBackoff {
+		t.Errorf("Expected 11 attempts and the default backoff, got %+v", policy)
+	}
+
+	policy = (&Config{RetryCount: 10, Retry: RetryPolicy{MaxAttempts: 3, MaxBackoff: time.Second}}).retryPolicy()
+	if policy.MaxAttempts != 3 || policy.MaxBackoff != time.Second {
+		t.Errorf("Expected the configured policy, got %+v", policy)
+	}
+}
+
+func TestRetryPolicyStatusCodes(t *testing.T) {
+	for code, expected := range map[int]bool{200: false, 404: false, 408: true, 429: true, 500: true, 501: false, 503: true} {
+		if retryable := (RetryPolicy{}).retryableStatus(code); retryable != expected {
+			t.Errorf("Expected %d to be retryable %t by default", code, expected)
+		}
+	}
+
+	policy := RetryPolicy{RetryableStatusCodes: []int{409, 503}}
+	for code, expected := range map[int]bool{409: true, 429: false, 500: false, 503: true} {
+		if retryable := policy.retryableStatus(code); retryable != expected {
+			t.Errorf("Expected %d to be retryable %t", code, expected)
+		}
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{HonorRetryAfter: true}
+	for attempt, wait := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		backoff := policy.backoff(time.Second, 10*time.Second, attempt, nil)
+		if backoff < wait/2 || backoff > wait {
+			t.Errorf("Expected a backoff between %s and %s for the attempt %d, got %s", wait/2, wait, attempt, backoff)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"3"}}}
+	if backoff := policy.backoff(time.Second, 10*time.Second, 0, resp); backoff != 3*time.Second {
+		t.Errorf("Expected the Retry-After wait, got %s", backoff)
+	}
+	if backoff := policy.backoff(time.Second, 2*time.Second, 0, resp); backoff != 2*time.Second {
+		t.Errorf("Expected the Retry-After wait to be capped, got %s", backoff)
+	}
+	if backoff := (RetryPolicy{}).backoff(time.Second, 10*time.Second, 0, resp); backoff > time.Second {
+		t.Errorf("Expected the Retry-After header to be ignored, got %s", backoff)
+	}
+}
+
+func TestRetryPolicyApply(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, test := range []struct {
+		policy   RetryPolicy
+		status   int
+		requests int32
+	}{
+		{RetryPolicy{MaxAttempts: 5, MaxBackoff: time.Second, HonorRetryAfter: true}, http.StatusOK, 3},
+		{RetryPolicy{MaxAttempts: 2, MaxBackoff: time.Second, HonorRetryAfter: true}, http.StatusServiceUnavailable, 2},
+		{RetryPolicy{MaxAttempts: 5, MaxBackoff: time.Second, HonorRetryAfter: true, RetryableStatusCodes: []int{429}}, http.StatusServiceUnavailable, 1},
+		{RetryPolicy{MaxAttempts: 1}, http.StatusServiceUnavailable, 1},
+	} {
+		atomic.StoreInt32(&requests, 0)
+
+		service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.policy.Apply(service)
+
+		resp, err := service.Client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status || atomic.LoadInt32(&requests) != test.requests {
+			t.Errorf("Expected %d after %d requests with %+v, got %d after %d", test.status, test.requests, test.policy, resp.StatusCode, requests)
+		}
+	}
+}
+
+func TestRetryPolicyHTTPClient(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := RetryPolicy{MaxAttempts: 3, MaxBackoff: 10 * time.Millisecond}.HTTPClient(&http.Client{})
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || requests != 2 {
+		t.Errorf("Expected 200 after 2 requests, got %d after %d", resp.StatusCode, requests)
+	}
+}
+
+func TestFetchUserDetailsRetry(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      "IBMid-1",
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"account": map[string]interface{}{"bss": "account1"},
+	}).SignedString([]byte("key1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := "https://iam.cloud.ibm.com"
+	sess := &bxsession.Session{Config: &bluemix.Config{
+		IAMAccessToken:        "invalid",
+		TokenProviderEndpoint: &endpoint,
+		Authenticator:         &core.BearerTokenAuthenticator{BearerToken: token},
+	}}
+
+	// the token that cannot be parsed is fetched again
+	user, err := fetchUserDetails(sess, RetryPolicy{MaxAttempts: 3, MaxBackoff: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.UserAccount != "account1" || user.CloudName != "bluemix" {
+		t.Errorf("Expected the user details of account1, got %+v", user)
+	}
+
+	sess.Config.IAMAccessToken = "invalid"
+	if _, err := fetchUserDetails(sess, RetryPolicy{MaxAttempts: 1}); err == nil {
+		t.Errorf("Expected an error without retries")
+	}
+}
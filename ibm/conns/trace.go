@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// maxTracedBodySize is the maximum size of a request or response body that is traced
+const maxTracedBodySize = 64 * 1024
+
+const redacted = "[redacted]"
+
+// secretField matches the names of the headers, query parameters and body fields whose values are
+// redacted from the traces
+var secretField = regexp.MustCompile(`(?i)(authorization|cookie|token|secret|passw|passphrase|api[_-]?key|private[_-]?key|credential|signature|payload)`)
+
+// requestIDHeaders are the headers that identify a request to the IBM Cloud support
+var requestIDHeaders = []string{"X-Request-Id", "Transaction-Id", "X-Global-Transaction-Id"}
+
+var (
+	wireTracersMu sync.Mutex
+	wireTracers   = map[string]*wireTracer{}
+)
+
+// wireTracer writes the redacted HTTP requests and responses of the API calls to a file, one JSON
+// object per line
+type wireTracer struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// wireTraceRecord is a line of the trace file
+type wireTraceRecord struct {
+	Time                  string            `json:"time"`
+	Resource              string            `json:"resource,omitempty"`
+	Operation             string            `json:"operation,omitempty"`
+	Method                string            `json:"method"`
+	URL                   string            `json:"url"`
+	Status                int               `json:"status,omitempty"`
+	LatencyMs             int64             `json:"latency_ms"`
+	RequestID             string            `json:"request_id,omitempty"`
+	CorrelationID         string            `json:"correlation_id,omitempty"`
+	Error                 string            `json:"error,omitempty"`
+	RequestHeaders        map[string]string `json:"request_headers,omitempty"`
+	RequestBody           json.RawMessage   `json:"request_body,omitempty"`
+	RequestBodyTruncated  bool              `json:"request_body_truncated,omitempty"`
+	ResponseHeaders       map[string]string `json:"response_headers,omitempty"`
+	ResponseBody          json.RawMessage   `json:"response_body,omitempty"`
+	ResponseBodyTruncated bool              `json:"response_body_truncated,omitempty"`
+}
+
+type traceOperationKey struct{}
+
+type traceOperation struct {
+	resource, operation string
+}
+
+// WithTraceOperation returns a context whose API calls are traced as calls of the given operation
+// of the given resource
+func WithTraceOperation(ctx context.Context, resource, operation string) context.Context {
+	return context.WithValue(ctx, traceOperationKey{}, traceOperation{resource: resource, operation: operation})
+}
+
+// openWireTracer returns the tracer that writes to the given file, or nil when the file is empty.
+// The tracers are shared by the provider configurations that trace to the same file.
+func openWireTracer(path string) (*wireTracer, error) {
+	if path == "" {
+		return nil, nil
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error resolving the HTTP trace file %s: %s", path, err)
+	}
+
+	wireTracersMu.Lock()
+	defer wireTracersMu.Unlock()
+	if tracer, ok := wireTracers[path]; ok {
+		return tracer, nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error opening the HTTP trace file %s: %s", path, err)
+	}
+	log.Printf("[INFO] Tracing the HTTP requests to %s", path)
+	tracer := &wireTracer{out: file}
+	wireTracers[path] = tracer
+	return tracer, nil
+}
+
+// RoundTripper returns a round tripper that traces the requests made with the given one
+func (t *wireTracer) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if t == nil {
+		return next
+	}
+	if transport, ok := next.(*traceTransport); ok && transport.tracer == t {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &traceTransport{next: next, tracer: t}
+}
+
+func (t *wireTracer) write(record wireTraceRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] Error tracing the HTTP request %s %s: %s", record.Method, record.URL, err)
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := t.out.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] Error tracing the HTTP request %s %s: %s", record.Method, record.URL, err)
+	}
+}
+
+type traceTransport struct {
+	next   http.RoundTripper
+	tracer *wireTracer
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := wireTraceRecord{
+		Time:           time.Now().UTC().Format(time.RFC3339Nano),
+		Method:         req.Method,
+		URL:            redactURL(req.URL),
+		CorrelationID:  req.Header.Get("X-Correlation-Id"),
+		RequestHeaders: redactHeaders(req.Header),
+	}
+	if operation, ok := req.Context().Value(traceOperationKey{}).(traceOperation); ok {
+		record.Resource, record.Operation = operation.resource, operation.operation
+	}
+	record.RequestBody, record.RequestBodyTruncated = traceRequestBody(req)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	record.LatencyMs = time.Since(start).Milliseconds()
+
+	for _, header := range requestIDHeaders {
+		if record.RequestID = req.Header.Get(header); record.RequestID != "" {
+			break
+		}
+	}
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = resp.StatusCode
+		for _, header := range requestIDHeaders {
+			if id := resp.Header.Get(header); id != "" {
+				record.RequestID = id
+				break
+			}
+		}
+		if id := resp.Header.Get("X-Correlation-Id"); id != "" {
+			record.CorrelationID = id
+		}
+		record.ResponseHeaders = redactHeaders(resp.Header)
+		record.ResponseBody, record.ResponseBodyTruncated = traceResponseBody(resp)
+	}
+	t.tracer.write(record)
+	return resp, err
+}
+
+// traceRequestBody returns the redacted body of the request, without consuming it
+func traceRequestBody(req *http.Request) (json.RawMessage, bool) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil || !tracedContentType(req.Header.Get("Content-Type")) {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	defer body.Close()
+	content, err := io.ReadAll(io.LimitReader(body, maxTracedBodySize+1))
+	if err != nil {
+		return nil, false
+	}
+	return redactBody(req.Header.Get("Content-Type"), content)
+}
+
+// traceResponseBody returns the redacted body of the response, which is still read in full by the
+// caller
+func traceResponseBody(resp *http.Response) (json.RawMessage, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody || !tracedContentType(resp.Header.Get("Content-Type")) {
+		return nil, false
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxTracedBodySize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(content), resp.Body), resp.Body}
+	if err != nil {
+		return nil, false
+	}
+	return redactBody(resp.Header.Get("Content-Type"), content)
+}
+
+// tracedContentType returns whether the bodies of the content type are traced. Binary bodies, such
+// as the objects of a bucket, are not.
+func tracedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(mediaType, "json") || mediaType == "application/x-www-form-urlencoded" || strings.HasPrefix(mediaType, "text/")
+}
+
+// redactBody returns the body with the values of the secret fields redacted, as a JSON value, and
+// whether it was truncated
+func redactBody(contentType string, content []byte) (json.RawMessage, bool) {
+	truncated := len(content) > maxTracedBodySize
+	if truncated {
+		content = content[:maxTracedBodySize]
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil, truncated
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	var text string
+	switch {
+	case !truncated && strings.HasSuffix(mediaType, "json"):
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err == nil {
+			if redactedJSON, err := json.Marshal(redactValue(value)); err == nil {
+				return redactedJSON, false
+			}
+		}
+		text = core.RedactSecrets(string(content))
+	case !truncated && mediaType == "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(string(content)); err == nil {
+			text = redactValues(values).Encode()
+		} else {
+			text = core.RedactSecrets(string(content))
+		}
+	default:
+		text = core.RedactSecrets(string(content))
+	}
+	quoted, _ := json.Marshal(text)
+	return quoted, truncated
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if secretField.MatchString(key) {
+				value[key] = redacted
+			} else {
+				value[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element)
+		}
+	}
+	return value
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if secretField.MatchString(key) {
+			values[key] = []string{redacted}
+		}
+	}
+	return values
+}
+
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	redactedURL.User = nil
+	if redactedURL.RawQuery != "" {
+		redactedURL.RawQuery = redactValues(redactedURL.Query()).Encode()
+	}
+	return redactedURL.String()
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if secretField.MatchString(name) || strings.HasPrefix(strings.ToLower(name), "x-auth") {
+			headers[name] = redacted
+		} else {
+			headers[name] = strings.Join(values, ", ")
+		}
+	}
+	return headers
+}
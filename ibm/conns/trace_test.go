@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func readTraceRecords(t *testing.T, path string) []map[string]interface{} {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var records []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Expected a JSON line, got %s: %s", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestWireTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request1")
+		w.Header().Set("Set-Cookie", "session=cookie1")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id":"key1","access_token":"token1","nested":[{"private_key":{"pem":"pem1"},"name":"name1"}]}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := openWireTracer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shared, _ := openWireTracer(path); shared != tracer {
+		t.Errorf("Expected the tracer of the file to be shared")
+	}
+
+	ctx := WithTraceOperation(context.Background(), "ibm_is_vpc", "create")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/keys?version=2026-01-01&apikey=apikey1", strings.NewReader(`{"name":"name1","password":"password1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer token1")
+	req.Header.Set("X-Correlation-Id", "correlation1")
+
+	client := &http.Client{Transport: tracer.RoundTripper(nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "token1") {
+		t.Errorf("Expected the caller to read the response body in full, got %s", body)
+	}
+
+	records := readTraceRecords(t, path)
+	if len(records) != 1 {
+		t.Fatalf("Expected a trace record, got %d", len(records))
+	}
+	record := records[0]
+	for key, value := range map[string]interface{}{
+		"resource":       "ibm_is_vpc",
+		"operation":      "create",
+		"method":         "POST",
+		"status":         float64(201),
+		"request_id":     "request1",
+		"correlation_id": "correlation1",
+	} {
+		if record[key] != value {
+			t.Errorf("Expected %s %v, got %v", key, value, record[key])
+		}
+	}
+	if url := record["url"].(string); !strings.Contains(url, "version=2026-01-01") || strings.Contains(url, "apikey1") {
+		t.Errorf("Expected the apikey to be redacted from the URL, got %s", url)
+	}
+	if authorization := record["request_headers"].(map[string]interface{})["Authorization"]; authorization != redacted {
+		t.Errorf("Expected the Authorization header to be redacted, got %v", authorization)
+	}
+	if cookie := record["response_headers"].(map[string]interface{})["Set-Cookie"]; cookie != redacted {
+		t.Errorf("Expected the Set-Cookie header to be redacted, got %v", cookie)
+	}
+	if requestBody := record["request_body"].(map[string]interface{}); requestBody["name"] != "name1" || requestBody["password"] != redacted {
+		t.Errorf("Expected the password to be redacted from the request body, got %v", requestBody)
+	}
+
+	line, _ := os.ReadFile(path)
+	for _, secret := range []string{"token1", "apikey1", "password1", "pem1", "cookie1"} {
+		if strings.Contains(string(line), secret) {
+			t.Errorf("Expected %s to be redacted, got %s", secret, line)
+		}
+	}
+}
+
+func TestWireTracerForm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte("binary"))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := openWireTracer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey&apikey=apikey1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := (&http.Client{Transport: tracer.RoundTripper(nil)}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	record := readTraceRecords(t, path)[0]
+	if body := record["request_body"].(string); !strings.Contains(body, "grant_type=") || strings.Contains(body, "apikey1") {
+		t.Errorf("Expected the apikey to be redacted from the form, got %s", body)
+	}
+	if _, ok := record["response_body"]; ok {
+		t.Errorf("Expected the binary response body not to be traced, got %v", record["response_body"])
+	}
+}
+
+func TestClientTransportApply(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	transport, err := (&Config{RetryCount: 2, HTTPTraceFile: path}).clientTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.Apply(service)
+	transport.Apply(service)
+
+	resp, err := service.Client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if records := readTraceRecords(t, path); len(records) != 1 {
+		t.Errorf("Expected a trace record, got %d", len(records))
+	}
+
+	if transport, _ := (&Config{}).clientTransport(); transport.authenticatorClient() != nil {
+		t.Errorf("Expected the default client of the authenticators without a trace file")
+	}
+}
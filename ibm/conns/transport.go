@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	bxhttp "github.com/IBM-Cloud/bluemix-go/http"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// maxIdleConnsPerHost is the number of connections to an API that are kept alive for reuse
+const maxIdleConnsPerHost = 16
+
+// clientTransport is the HTTP configuration shared by the service clients
+type clientTransport struct {
+	retry    RetryPolicy
+	tracer   *wireTracer
+	limiters *rateLimiters
+
+	// tlsConfig has the trusted certificate authorities and the client certificate, or is nil for
+	// the defaults of the clients
+	tlsConfig         *tls.Config
+	proxyURL          *url.URL
+	disableKeepAlives bool
+}
+
+// clientTransport returns the HTTP configuration of the service clients of the config
+func (c *Config) clientTransport() (clientTransport, error) {
+	tracer, err := openWireTracer(c.HTTPTraceFile)
+	if err != nil {
+		return clientTransport{}, err
+	}
+	transport := clientTransport{
+		retry:             c.retryPolicy(),
+		tracer:            tracer,
+		limiters:          newRateLimiters(c.rateLimits()),
+		disableKeepAlives: c.DisableKeepAlives,
+	}
+
+	if c.CABundleFile != "" || c.ClientCertificateFile != "" {
+		transport.tlsConfig = &tls.Config{}
+	}
+	if c.CABundleFile != "" {
+		pem, err := os.ReadFile(c.CABundleFile)
+		if err != nil {
+			return clientTransport{}, fmt.Errorf("[ERROR] Error reading the CA bundle file %s: %s", c.CABundleFile, err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return clientTransport{}, fmt.Errorf("[ERROR] The CA bundle file %s does not contain any PEM certificate", c.CABundleFile)
+		}
+		transport.tlsConfig.RootCAs = pool
+	}
+	if c.ClientCertificateFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.ClientCertificateFile, c.ClientKeyFile)
+		if err != nil {
+			return clientTransport{}, fmt.Errorf("[ERROR] Error loading the client certificate %s: %s", c.ClientCertificateFile, err)
+		}
+		transport.tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return clientTransport{}, fmt.Errorf("[ERROR] Invalid proxy URL %s", c.ProxyURL)
+		}
+		transport.proxyURL = proxyURL
+	}
+	return transport, nil
+}
+
+// Apply sets the HTTP configuration on the service of an IBM Cloud SDK client
+func (t clientTransport) Apply(service *core.BaseService) {
+	if service == nil {
+		return
+	}
+	t.retry.Apply(service)
+	// every attempt of a retried request is made with the transport
+	client := service.GetHTTPClient()
+	client.Transport = t.RoundTripper(client.Transport)
+}
+
+// RoundTripper returns a round tripper that makes the requests of the given one with the HTTP
+// configuration, except for the retries. Every attempt is rate limited and traced.
+func (t clientTransport) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if transport, ok := next.(*rateLimitTransport); ok && transport.limiters == t.limiters {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if transport, ok := next.(*http.Transport); ok {
+		next = t.configure(transport)
+	}
+	return t.limiters.RoundTripper(t.tracer.RoundTripper(next))
+}
+
+// HTTPClient returns a client that makes the requests of the given client with the HTTP
+// configuration
+func (t clientTransport) HTTPClient(client *http.Client) *http.Client {
+	configuredClient := *client
+	configuredClient.Transport = t.RoundTripper(client.Transport)
+	return t.retry.HTTPClient(&configuredClient)
+}
+
+// configure returns a copy of the transport with the connection settings
+func (t clientTransport) configure(transport *http.Transport) *http.Transport {
+	configured := transport.Clone()
+	if t.tlsConfig != nil {
+		tlsConfig := t.tlsConfig.Clone()
+		if transport.TLSClientConfig != nil {
+			tlsConfig.InsecureSkipVerify = transport.TLSClientConfig.InsecureSkipVerify
+			tlsConfig.MinVersion = transport.TLSClientConfig.MinVersion
+		}
+		configured.TLSClientConfig = tlsConfig
+	}
+	if t.proxyURL != nil {
+		configured.Proxy = http.ProxyURL(t.proxyURL)
+	}
+	configured.DisableKeepAlives = t.disableKeepAlives
+	if !t.disableKeepAlives && configured.MaxIdleConnsPerHost <= 0 {
+		configured.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	}
+	return configured
+}
+
+// customConnections returns whether the connections differ from the defaults of the clients, other
+// than being kept alive
+func (t clientTransport) customConnections() bool {
+	return t.tlsConfig != nil || t.proxyURL != nil || t.disableKeepAlives
+}
+
+// authenticatorClient returns the client of the token requests of the IAM authenticators, or nil
+// for their default client. The token requests are retried with the retry policy.
+func (t clientTransport) authenticatorClient() *http.Client {
+	if t.tracer == nil && !t.customConnections() && t.retry.MaxAttempts <= 1 {
+		return nil
+	}
+	client := core.DefaultHTTPClient()
+	client.Timeout = 30 * time.Second
+	client.Transport = t.RoundTripper(client.Transport)
+	return t.retry.HTTPClient(client)
+}
+
+// softLayerHTTPClient returns the client of the SoftLayer session, or nil for its default client
+func (t clientTransport) softLayerHTTPClient() *http.Client {
+	if t.tracer == nil && !t.customConnections() {
+		return nil
+	}
+	return &http.Client{Transport: t.RoundTripper(nil)}
+}
+
+// bluemixHTTPClient returns the client of the bluemix-go clients, before the retries and the
+// tracing are added
+func (t clientTransport) bluemixHTTPClient(config *bluemix.Config) *http.Client {
+	client := bxhttp.NewHTTPClient(config)
+	if !t.customConnections() {
+		return client
+	}
+	// the transport of bluemix-go with the connection settings
+	client.Transport = bxhttp.NewTraceLoggingTransport(t.configure(&http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   50 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout: 20 * time.Second,
+		DisableCompression:  true,
+		TLSClientConfig: &tls.Config{
+			InsecureSkipVerify: config.SSLDisable,
+		},
+	}))
+	return client
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bluemix "github.com/IBM-Cloud/bluemix-go"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key to PEM files
+func writeTestCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client-key.pem")
+	os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	return certFile, keyFile
+}
+
+// newTestTLSServer returns a TLS server that requires a client certificate, and the PEM file of
+// its certificate authority
+func newTestTLSServer(t *testing.T, dir string) (*httptest.Server, string) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+
+	caFile := filepath.Join(dir, "ca.pem")
+	os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)
+	return server, caFile
+}
+
+func TestClientTransportTLS(t *testing.T) {
+	dir := t.TempDir()
+	server, caFile := newTestTLSServer(t, dir)
+	defer server.Close()
+	certFile, keyFile := writeTestCertificate(t, dir)
+
+	transport, err := (&Config{CABundleFile: caFile, ClientCertificateFile: certFile, ClientKeyFile: keyFile}).clientTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the clients of the IBM Cloud SDKs
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.Apply(service)
+	resp, err := service.Client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected the client certificate to be sent, got %d", resp.StatusCode)
+	}
+
+	// the clients of DefaultTransport, bluemix-go, SoftLayer and the IAM authenticators
+	for name, client := range map[string]*http.Client{
+		"DefaultTransport": {Transport: transport.RoundTripper(DefaultTransport())},
+		"bluemix":          transport.HTTPClient(transport.bluemixHTTPClient(&bluemix.Config{HTTPTimeout: time.Minute})),
+		"SoftLayer":        transport.softLayerHTTPClient(),
+		"authenticator":    transport.authenticatorClient(),
+	} {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Errorf("Expected the CA bundle to be trusted by the %s client, got %s", name, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Expected the client certificate to be sent by the %s client, got %d", name, resp.StatusCode)
+		}
+	}
+
+	// the server is not trusted without the CA bundle
+	if _, err := http.DefaultClient.Get(server.URL); err == nil {
+		t.Errorf("Expected the certificate of the server to be untrusted by default")
+	}
+}
+
+func TestClientTransportErrors(t *testing.T) {
+	dir := t.TempDir()
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	os.WriteFile(invalidFile, []byte("invalid"), 0600)
+
+	for _, config := range []*Config{
+		{CABundleFile: filepath.Join(dir, "missing.pem")},
+		{CABundleFile: invalidFile},
+		{ClientCertificateFile: invalidFile, ClientKeyFile: invalidFile},
+		{ProxyURL: "proxy"},
+	} {
+		if _, err := config.clientTransport(); err == nil {
+			t.Errorf("Expected an error with %+v", config)
+		}
+	}
+}
+
+func TestClientTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	transport, err := (&Config{ProxyURL: proxy.URL}).clientTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Transport: transport.RoundTripper(DefaultTransport())}).Get("http://iam.cloud.ibm.com/identity/token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if proxied != "http://iam.cloud.ibm.com/identity/token" {
+		t.Errorf("Expected the request to be made through the proxy, got %q", proxied)
+	}
+}
+
+func TestClientTransportKeepAlive(t *testing.T) {
+	transport, _ := (&Config{}).clientTransport()
+	configured := transport.RoundTripper(DefaultTransport()).(*rateLimitTransport).next.(*http.Transport)
+	if configured.DisableKeepAlives || configured.MaxIdleConnsPerHost != maxIdleConnsPerHost {
+		t.Errorf("Expected the connections to be kept alive, got %t and %d", configured.DisableKeepAlives, configured.MaxIdleConnsPerHost)
+	}
+	if transport.softLayerHTTPClient() != nil || transport.authenticatorClient() != nil {
+		t.Errorf("Expected the default clients of SoftLayer and the authenticators")
+	}
+
+	transport, _ = (&Config{DisableKeepAlives: true}).clientTransport()
+	if configured := transport.RoundTripper(nil).(*rateLimitTransport).next.(*http.Transport); !configured.DisableKeepAlives {
+		t.Errorf("Expected the connections not to be kept alive")
+	}
+}
+
+func TestClientTransportAuthenticatorRetry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token": "token1", "refresh_token": "refresh1", "token_type": "Bearer", "expires_in": 3600, "expiration": 4102444800}`))
+	}))
+	defer server.Close()
+
+	transport, _ := (&Config{RetryCount: 2, Retry: RetryPolicy{MaxBackoff: 10 * time.Millisecond}}).clientTransport()
+	authenticator := &core.IamAuthenticator{ApiKey: "apikey1", URL: server.URL, Client: transport.authenticatorClient()}
+	token, err := authenticator.RequestToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "token1" || requests != 2 {
+		t.Errorf("Expected the token after 2 requests, got %q after %d", token.AccessToken, requests)
+	}
+}
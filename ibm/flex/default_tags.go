@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultTagsOperation runs an operation of a resource with default tags, whose user tags are the
+// attribute of the key. The call runs the operation of the resource itself.
+type defaultTagsOperation func(key string, d *schema.ResourceData, meta interface{}, call func() error) error
+
+// errDiagnostics is returned by the call of a context function that failed with diagnostics, which
+// are returned as they are.
+var errDiagnostics = errors.New("diagnostics")
+
+// defaultTagsAttributes are the attributes of the user tags of the resources. The Power resources
+// prefix their arguments with pi_.
+var defaultTagsAttributes = []string{"tags", "pi_user_tags"}
+
+// defaultTagsCRNAttributes are the attributes of the CRN used to attach the tags of the resources.
+var defaultTagsCRNAttributes = []string{"crn", ResourceCRN}
+
+// WithDefaultTags adds a computed tags_all attribute to the resource, when its user tags are
+// attached with the global tagging service. tags_all is the effective tag set of the resource: its
+// user tags merged with the default_tags of the provider. The default tags are attached after
+// create, and are left out of the user tags on read unless they are also set in the configuration,
+// so that they never show up as a diff of the user tags.
+func WithDefaultTags(resource *schema.Resource) *schema.Resource {
+	key := defaultTagsAttribute(resource)
+	if key == "" {
+		return resource
+	}
+	if _, ok := resource.Schema[TagsAll]; ok {
+		return resource
+	}
+
+	resourceSchema := make(map[string]*schema.Schema, len(resource.Schema)+1)
+	for key, value := range resource.Schema {
+		resourceSchema[key] = value
+	}
+	resourceSchema[TagsAll] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         ResourceIBMVPCHash,
+		Description: "The tags of the resource, including the default_tags of the provider",
+	}
+	resource.Schema = resourceSchema
+
+	resource.CustomizeDiff = defaultTagsCustomizeDiff(key, resource.CustomizeDiff)
+
+	resource.CreateContext = wrapDefaultTagsContextFunc(key, resource.CreateContext, createDefaultTags)
+	resource.CreateWithoutTimeout = wrapDefaultTagsContextFunc(key, resource.CreateWithoutTimeout, createDefaultTags)
+	resource.Create = wrapDefaultTagsFunc(key, resource.Create, createDefaultTags)
+	resource.ReadContext = wrapDefaultTagsContextFunc(key, resource.ReadContext, readDefaultTags)
+	resource.ReadWithoutTimeout = wrapDefaultTagsContextFunc(key, resource.ReadWithoutTimeout, readDefaultTags)
+	resource.Read = wrapDefaultTagsFunc(key, resource.Read, readDefaultTags)
+
+	if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+		// resources that are replaced on every change only update the default tags in place
+		resource.UpdateContext = wrapDefaultTagsContextFunc(key, func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		}, updateDefaultTags)
+	} else {
+		resource.UpdateContext = wrapDefaultTagsContextFunc(key, resource.UpdateContext, updateDefaultTags)
+		resource.UpdateWithoutTimeout = wrapDefaultTagsContextFunc(key, resource.UpdateWithoutTimeout, updateDefaultTags)
+		resource.Update = wrapDefaultTagsFunc(key, resource.Update, updateDefaultTags)
+	}
+	return resource
+}
+
+// defaultTagsAttribute returns the attribute of the user tags of the resource, when they are
+// attached with the global tagging service: an optional set of tags that is also computed, as it is
+// read back from the tagging service, of a resource with a CRN. It returns "" otherwise.
+func defaultTagsAttribute(resource *schema.Resource) string {
+	hasCRN := false
+	for _, key := range defaultTagsCRNAttributes {
+		if attribute, ok := resource.Schema[key]; ok && attribute.Type == schema.TypeString {
+			hasCRN = true
+		}
+	}
+	if !hasCRN {
+		return ""
+	}
+
+	for _, key := range defaultTagsAttributes {
+		attribute, ok := resource.Schema[key]
+		if !ok || attribute.Type != schema.TypeSet || !attribute.Optional || !attribute.Computed {
+			continue
+		}
+		if elem, ok := attribute.Elem.(*schema.Schema); ok && elem.Type == schema.TypeString {
+			return key
+		}
+	}
+	return ""
+}
+
+func wrapDefaultTagsContextFunc(key string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, operation defaultTagsOperation) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		err := operation(key, d, meta, func() error {
+			diags = f(ctx, d, meta)
+			if diags.HasError() {
+				return errDiagnostics
+			}
+			return nil
+		})
+		if errors.Is(err, errDiagnostics) {
+			return diags
+		}
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func wrapDefaultTagsFunc(key string, f func(*schema.ResourceData, interface{}) error, operation defaultTagsOperation) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return operation(key, d, meta, func() error {
+			return f(d, meta)
+		})
+	}
+}
+
+func createDefaultTags(key string, d *schema.ResourceData, meta interface{}, call func() error) error {
+	tags, tagsAll := d.Get(key), d.Get(TagsAll)
+	if err := call(); err != nil || d.Id() == "" {
+		return err
+	}
+
+	if len(DefaultTags(meta)) > 0 {
+		crn := defaultTagsCRN(d)
+		if crn == "" {
+			log.Printf("[WARN] The default tags are not attached to %s, it does not have a CRN", d.Id())
+		} else {
+			olds, news := mergeDefaultTags(tags.(*schema.Set), tags.(*schema.Set), meta)
+			if err := UpdateTagsUsingCRN(olds, news, meta, crn); err != nil {
+				return err
+			}
+		}
+	}
+	refreshDefaultTags(key, d, meta, tags, tagsAll)
+	return nil
+}
+
+func readDefaultTags(key string, d *schema.ResourceData, meta interface{}, call func() error) error {
+	tags, tagsAll := d.Get(key), d.Get(TagsAll)
+	if err := call(); err != nil || d.Id() == "" {
+		return err
+	}
+	refreshDefaultTags(key, d, meta, tags, tagsAll)
+	return nil
+}
+
+func updateDefaultTags(key string, d *schema.ResourceData, meta interface{}, call func() error) error {
+	tags, tagsAll := d.Get(key), d.Get(TagsAll)
+	detach, attach := defaultTagsChange(key, d, meta)
+	if d.HasChangesExcept(TagsAll, DeletionProtection) {
+		if err := call(); err != nil {
+			return err
+		}
+	}
+
+	if detach.Len() > 0 || attach.Len() > 0 {
+		if crn := defaultTagsCRN(d); crn != "" {
+			if err := UpdateTagsUsingCRN(detach, attach, meta, crn); err != nil {
+				return err
+			}
+		} else {
+			log.Printf("[WARN] The default tags of %s are not updated, it does not have a CRN", d.Id())
+		}
+	}
+	if d.Id() != "" {
+		refreshDefaultTags(key, d, meta, tags, tagsAll)
+	}
+	return nil
+}
+
+// refreshDefaultTags sets the user tags and tags_all after an operation of the resource. The
+// default tags are removed from the user tags, unless they were set before, and tags_all keeps the default tags that it
+// already had, for the resources whose tags are not read from the tagging service.
+func refreshDefaultTags(key string, d *schema.ResourceData, meta interface{}, priorTags, priorTagsAll interface{}) {
+	defaults := NewStringSet(ResourceIBMVPCHash, DefaultTags(meta))
+	tags := tagStrings(d.Get(key))
+
+	if defaults.Len() > 0 {
+		hidden := defaults.Difference(NewStringSet(ResourceIBMVPCHash, tagStrings(priorTags)))
+		visible := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if !hidden.Contains(tag) {
+				visible = append(visible, tag)
+			}
+		}
+		if err := d.Set(key, visible); err != nil {
+			log.Printf("[WARN] Error setting the %s of %s: %s", key, d.Id(), err)
+		}
+	}
+
+	tagsAll := NewStringSet(ResourceIBMVPCHash, tags).Union(defaults.Intersection(NewStringSet(ResourceIBMVPCHash, tagStrings(priorTagsAll))))
+	if err := d.Set(TagsAll, tagsAll); err != nil {
+		log.Printf("[WARN] Error setting the %s of %s: %s", TagsAll, d.Id(), err)
+	}
+}
+
+func defaultTagsCustomizeDiff(key string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed(TagsAll)
+		}
+		tagsAll := NewStringSet(ResourceIBMVPCHash, tagStrings(diff.Get(key))).Union(NewStringSet(ResourceIBMVPCHash, DefaultTags(meta)))
+		if oldTagsAll, _ := diff.GetChange(TagsAll); diff.Id() != "" && tagsAll.Equal(NewStringSet(ResourceIBMVPCHash, tagStrings(oldTagsAll))) {
+			return nil
+		}
+		return diff.SetNew(TagsAll, tagsAll)
+	}
+}
+
+// defaultTagsChange returns the tags to detach and attach on update, for the changes of tags_all
+// that the resource does not apply itself by updating its user tags. It is called before the update, as
+// the resource may read the tags of the remote resource back into its user tags.
+func defaultTagsChange(key string, d *schema.ResourceData, meta interface{}) (*schema.Set, *schema.Set) {
+	oldTags, newTags := d.GetChange(key)
+	oldTagsAll, _ := d.GetChange(TagsAll)
+	olds := NewStringSet(ResourceIBMVPCHash, tagStrings(oldTags))
+	news := NewStringSet(ResourceIBMVPCHash, tagStrings(newTags))
+	_, newTagsAll := mergeDefaultTags(news, news, meta)
+
+	// the resource detaches the tags removed from its user tags, and attaches the tags added to them
+	detached := olds.Difference(news)
+	detach := NewStringSet(ResourceIBMVPCHash, tagStrings(oldTagsAll)).Difference(newTagsAll).Difference(detached)
+	attach := newTagsAll.Difference(NewStringSet(ResourceIBMVPCHash, tagStrings(oldTagsAll))).Difference(news.Difference(olds)).Union(detached.Intersection(newTagsAll))
+	return detach, attach
+}
+
+// mergeDefaultTags returns the tags of a tag update by CRN, with the default tags of the provider
+// added to the new tags. Only the resources with default tags attach the default tags, the shared
+// tag update helpers do not merge them.
+func mergeDefaultTags(olds, news *schema.Set, meta interface{}) (*schema.Set, *schema.Set) {
+	defaults := DefaultTags(meta)
+	if len(defaults) == 0 {
+		return olds, news
+	}
+	olds = NewStringSet(ResourceIBMVPCHash, tagStrings(olds))
+	news = NewStringSet(ResourceIBMVPCHash, tagStrings(news)).Union(NewStringSet(ResourceIBMVPCHash, defaults))
+	return olds, news
+}
+
+// DefaultTags returns the default_tags of the provider.
+func DefaultTags(meta interface{}) []string {
+	if session, ok := meta.(conns.ClientSession); ok {
+		return session.DefaultTags()
+	}
+	return nil
+}
+
+// defaultTagsCRN returns the CRN used to attach the tags of the resource.
+func defaultTagsCRN(d *schema.ResourceData) string {
+	for _, key := range defaultTagsCRNAttributes {
+		if crn, ok := d.GetOk(key); ok {
+			if crn, ok := crn.(string); ok && strings.HasPrefix(crn, "crn:") {
+				return crn
+			}
+		}
+	}
+	return ""
+}
+
+func tagStrings(tags interface{}) []string {
+	switch tags := tags.(type) {
+	case *schema.Set:
+		return ExpandStringList(tags.List())
+	case []interface{}:
+		return ExpandStringList(tags)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+type defaultTagsSession struct {
+	conns.ClientSession
+	defaultTags []string
+}
+
+func (s defaultTagsSession) DefaultTags() []string {
+	return s.defaultTags
+}
+
+// testDefaultTagsResource returns a resource whose read sets the given remote tags, or keeps the
+// tags of the state when they are nil.
+func testDefaultTagsResource(remote []string) *schema.Resource {
+	return WithDefaultTags(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+			"tags": {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: ResourceIBMVPCHash},
+			"crn":  {Type: schema.TypeString, Computed: true},
+		},
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("resource1")
+			return nil
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if remote != nil {
+				d.Set("tags", remote)
+			}
+			return nil
+		},
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	})
+}
+
+func testDefaultTagsData(resource *schema.Resource, tags, tagsAll []string) *schema.ResourceData {
+	d := resource.TestResourceData()
+	d.SetId("resource1")
+	d.Set("name", "name1")
+	d.Set("tags", tags)
+	d.Set(TagsAll, tagsAll)
+	return d
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	resource := testDefaultTagsResource(nil)
+
+	assert.Contains(t, resource.Schema, TagsAll)
+	assert.True(t, resource.Schema[TagsAll].Computed)
+	assert.NotNil(t, resource.UpdateContext)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	// the user tags of the Power resources
+	power := WithDefaultTags(&schema.Resource{Schema: map[string]*schema.Schema{
+		"pi_user_tags": {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"crn":          {Type: schema.TypeString, Computed: true},
+	}})
+	assert.Contains(t, power.Schema, TagsAll)
+
+	// the tags of the resource are not read back from the global tagging service
+	untagged := &schema.Resource{Schema: map[string]*schema.Schema{
+		"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		"crn":  {Type: schema.TypeString, Computed: true},
+	}}
+	assert.NotContains(t, WithDefaultTags(untagged).Schema, TagsAll)
+
+	// the resource does not have a CRN to attach the tags with
+	untagged = &schema.Resource{Schema: map[string]*schema.Schema{
+		"tags": {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}}
+	assert.NotContains(t, WithDefaultTags(untagged).Schema, TagsAll)
+}
+
+func TestDefaultTagsRead(t *testing.T) {
+	meta := defaultTagsSession{defaultTags: []string{"env:prod"}}
+
+	// the tags read from the tagging service include the default tags
+	resource := testDefaultTagsResource([]string{"team:a", "env:prod"})
+	d := testDefaultTagsData(resource, []string{"team:a"}, []string{"team:a", "env:prod"})
+	assert.False(t, resource.ReadContext(context.Background(), d, meta).HasError())
+	assert.ElementsMatch(t, []interface{}{"team:a"}, d.Get("tags").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"team:a", "env:prod"}, d.Get(TagsAll).(*schema.Set).List())
+
+	// a default tag that is also set in the configuration is kept in tags
+	d = testDefaultTagsData(resource, []string{"team:a", "env:prod"}, []string{"team:a", "env:prod"})
+	assert.False(t, resource.ReadContext(context.Background(), d, meta).HasError())
+	assert.ElementsMatch(t, []interface{}{"team:a", "env:prod"}, d.Get("tags").(*schema.Set).List())
+
+	// the tags are not read from the tagging service
+	resource = testDefaultTagsResource(nil)
+	d = testDefaultTagsData(resource, []string{"team:a"}, []string{"team:a", "env:prod"})
+	assert.False(t, resource.ReadContext(context.Background(), d, meta).HasError())
+	assert.ElementsMatch(t, []interface{}{"team:a"}, d.Get("tags").(*schema.Set).List())
+	assert.ElementsMatch(t, []interface{}{"team:a", "env:prod"}, d.Get(TagsAll).(*schema.Set).List())
+
+	// a default tag that is removed from the provider is no longer hidden
+	resource = testDefaultTagsResource([]string{"team:a", "env:prod"})
+	d = testDefaultTagsData(resource, []string{"team:a"}, []string{"team:a", "env:prod"})
+	assert.False(t, resource.ReadContext(context.Background(), d, defaultTagsSession{}).HasError())
+	assert.ElementsMatch(t, []interface{}{"team:a", "env:prod"}, d.Get("tags").(*schema.Set).List())
+}
+
+func TestDefaultTagsCustomizeDiff(t *testing.T) {
+	resource := testDefaultTagsResource(nil)
+	meta := defaultTagsSession{defaultTags: []string{"env:prod"}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "name1", "tags": []interface{}{"team:a"}})
+
+	diff, err := resource.Diff(context.Background(), nil, config, meta)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", diff.Attributes[TagsAll+".#"].New)
+
+	state, diags := resource.Apply(context.Background(), &terraform.InstanceState{}, diff, meta)
+	assert.False(t, diags.HasError())
+
+	// no diff when the default tags do not change
+	diff, err = resource.Diff(context.Background(), state, config, meta)
+	assert.Nil(t, err)
+	assert.True(t, diff.Empty())
+
+	// a new default tag only changes tags_all
+	diff, err = resource.Diff(context.Background(), state, config, defaultTagsSession{defaultTags: []string{"env:prod", "owner:b"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", diff.Attributes[TagsAll+".#"].New)
+	assert.NotContains(t, diff.Attributes, "tags.#")
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	olds := schema.NewSet(schema.HashString, []interface{}{"team:a"})
+	news := schema.NewSet(schema.HashString, []interface{}{"team:b"})
+
+	mergedOlds, mergedNews := mergeDefaultTags(olds, news, defaultTagsSession{})
+	assert.Same(t, olds, mergedOlds)
+	assert.Same(t, news, mergedNews)
+
+	mergedOlds, mergedNews = mergeDefaultTags(olds, news, defaultTagsSession{defaultTags: []string{"env:prod", "team:a"}})
+	assert.ElementsMatch(t, []interface{}{"team:a"}, mergedOlds.List())
+	assert.ElementsMatch(t, []interface{}{"team:b", "env:prod", "team:a"}, mergedNews.List())
+	assert.ElementsMatch(t, []interface{}{"team:b", "env:prod"}, mergedNews.Difference(mergedOlds).List())
+}
+
+func TestDefaultTagsChange(t *testing.T) {
+	resource := testDefaultTagsResource(nil)
+	change := func(state []interface{}, defaults []string, config []interface{}, newDefaults []string) (*schema.Set, *schema.Set) {
+		diff, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "name1", "tags": state}), defaultTagsSession{defaultTags: defaults})
+		assert.Nil(t, err)
+		prior, diags := resource.Apply(context.Background(), &terraform.InstanceState{}, diff, defaultTagsSession{defaultTags: defaults})
+		assert.False(t, diags.HasError())
+
+		meta := defaultTagsSession{defaultTags: newDefaults}
+		diff, err = resource.Diff(context.Background(), prior, terraform.NewResourceConfigRaw(map[string]interface{}{"name": "name1", "tags": config}), meta)
+		assert.Nil(t, err)
+		d, err := schema.InternalMap(resource.Schema).Data(prior, diff)
+		assert.Nil(t, err)
+		return defaultTagsChange("tags", d, meta)
+	}
+
+	// a changed default tag is applied next to the tags that the resource updates itself
+	detach, attach := change([]interface{}{"team:a"}, []string{"env:prod"}, []interface{}{"team:b"}, []string{"owner:b"})
+	assert.ElementsMatch(t, []interface{}{"env:prod"}, detach.List())
+	assert.ElementsMatch(t, []interface{}{"owner:b"}, attach.List())
+
+	// a default tag that is removed from tags is attached again
+	detach, attach = change([]interface{}{"team:a", "env:prod"}, []string{"env:prod"}, []interface{}{"team:a"}, []string{"env:prod"})
+	assert.Empty(t, detach.List())
+	assert.ElementsMatch(t, []interface{}{"env:prod"}, attach.List())
+
+	// nothing is left to apply when only tags change
+	detach, attach = change([]interface{}{"team:a"}, []string{"env:prod"}, []interface{}{"team:b"}, []string{"env:prod"})
+	assert.Empty(t, detach.List())
+	assert.Empty(t, attach.List())
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WithDeletionProtection adds an optional deletion_protection attribute to the resource, unless the
+// resource declares its own. Resources that are replaced on every change get an update that only
+// stores the new value of the attribute, so that it can be changed in place.
+func WithDeletionProtection(resource *schema.Resource) *schema.Resource {
+	if _, ok := resource.Schema[DeletionProtection]; ok {
+		return resource
+	}
+
+	resourceSchema := make(map[string]*schema.Schema, len(resource.Schema)+1)
+	for key, value := range resource.Schema {
+		resourceSchema[key] = value
+	}
+	resourceSchema[DeletionProtection] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether Terraform will be prevented from destroying the resource. Defaults to the deletion_protection of the provider",
+	}
+	resource.Schema = resourceSchema
+
+	if resource.Update == nil && resource.UpdateContext == nil && resource.UpdateWithoutTimeout == nil {
+		resource.UpdateContext = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		}
+	}
+	return resource
+}
+
+// DeletionProtected returns whether the resource is protected from deletion. The deletion_protection
+// value in state is used when it is set, otherwise the default of the provider applies.
+func DeletionProtected(d *schema.ResourceData, meta interface{}) bool {
+	state := d.GetRawState()
+	if !state.IsNull() && state.Type().HasAttribute(DeletionProtection) {
+		if value := state.GetAttr(DeletionProtection); value.IsKnown() && !value.IsNull() {
+			return value.True()
+		}
+	}
+
+	if session, ok := meta.(conns.ClientSession); ok {
+		return session.DeletionProtection()
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDeletionProtectionResource() *schema.Resource {
+	return WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+	})
+}
+
+func testDeletionProtectionData(resource *schema.Resource, value cty.Value) *schema.ResourceData {
+	return resource.Data(&terraform.InstanceState{
+		ID: "resource1",
+		RawState: cty.ObjectVal(map[string]cty.Value{
+			"id":               cty.StringVal("resource1"),
+			"name":             cty.StringVal("name1"),
+			DeletionProtection: value,
+		}),
+	})
+}
+
+func TestWithDeletionProtection(t *testing.T) {
+	resource := testDeletionProtectionResource()
+
+	assert.Contains(t, resource.Schema, DeletionProtection)
+	assert.True(t, resource.Schema[DeletionProtection].Optional)
+	assert.NotNil(t, resource.UpdateContext)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+}
+
+func TestWithDeletionProtectionDeclared(t *testing.T) {
+	declared := &schema.Schema{Type: schema.TypeBool, Optional: true, Default: false}
+	resource := WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			DeletionProtection: declared,
+		},
+	})
+
+	assert.Same(t, declared, resource.Schema[DeletionProtection])
+	assert.Nil(t, resource.UpdateContext)
+}
+
+func TestDeletionProtected(t *testing.T) {
+	resource := testDeletionProtectionResource()
+
+	assert.True(t, DeletionProtected(testDeletionProtectionData(resource, cty.True), nil))
+	assert.False(t, DeletionProtected(testDeletionProtectionData(resource, cty.False), nil))
+	assert.False(t, DeletionProtected(testDeletionProtectionData(resource, cty.NullVal(cty.Bool)), nil))
+}
+
+type deletionProtectionSession struct {
+	conns.ClientSession
+	deletionProtection bool
+}
+
+func (s deletionProtectionSession) DeletionProtection() bool {
+	return s.deletionProtection
+}
+
+func TestDeletionProtectedProviderDefault(t *testing.T) {
+	meta := deletionProtectionSession{deletionProtection: true}
+
+	// a resource that declares its own deletion_protection without a default
+	resource := WithDeletionProtection(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":             {Type: schema.TypeString, Required: true, ForceNew: true},
+			DeletionProtection: {Type: schema.TypeBool, Optional: true},
+		},
+	})
+	assert.True(t, DeletionProtected(testDeletionProtectionData(resource, cty.NullVal(cty.Bool)), meta))
+	assert.False(t, DeletionProtected(testDeletionProtectionData(resource, cty.False), meta))
+
+	resource = testDeletionProtectionResource()
+	assert.True(t, DeletionProtected(testDeletionProtectionData(resource, cty.NullVal(cty.Bool)), meta))
+}
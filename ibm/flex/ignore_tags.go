@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IgnoreTags returns the ignore_tags of the provider.
+func IgnoreTags(meta interface{}) conns.IgnoreTags {
+	if session, ok := meta.(conns.ClientSession); ok {
+		return session.IgnoreTags()
+	}
+	return conns.IgnoreTags{}
+}
+
+// withoutIgnoredTags returns the tags without the user tags matched by the ignore_tags of the
+// provider. Access and service tags are returned as they are.
+func withoutIgnoredTags(tags *schema.Set, meta interface{}, tagType string) *schema.Set {
+	ignoreTags := IgnoreTags(meta)
+	if tags == nil || (len(ignoreTags.Keys) == 0 && len(ignoreTags.KeyPrefixes) == 0) {
+		return tags
+	}
+	if strings.TrimSpace(tagType) != "" && tagType != "user" {
+		return tags
+	}
+
+	kept := make([]string, 0, tags.Len())
+	for _, tag := range tagStrings(tags) {
+		if !ignoreTags.Ignores(tag) {
+			kept = append(kept, tag)
+		}
+	}
+	return NewStringSet(ResourceIBMVPCHash, kept)
+}
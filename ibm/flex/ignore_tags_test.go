@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+type ignoreTagsSession struct {
+	conns.ClientSession
+	ignoreTags conns.IgnoreTags
+}
+
+func (s ignoreTagsSession) DefaultTags() []string {
+	return nil
+}
+
+func (s ignoreTagsSession) IgnoreTags() conns.IgnoreTags {
+	return s.ignoreTags
+}
+
+func TestWithoutIgnoredTags(t *testing.T) {
+	meta := ignoreTagsSession{ignoreTags: conns.IgnoreTags{Keys: []string{"CostCenter"}, KeyPrefixes: []string{"owner"}}}
+	tags := schema.NewSet(schema.HashString, []interface{}{"costcenter:123", "costcenter", "owner:finops", "ownership", "env:prod", "team:costcenter"})
+
+	assert.ElementsMatch(t, []interface{}{"env:prod", "team:costcenter"}, withoutIgnoredTags(tags, meta, "user").List())
+	assert.ElementsMatch(t, []interface{}{"env:prod", "team:costcenter"}, withoutIgnoredTags(tags, meta, "").List())
+	assert.Same(t, tags, withoutIgnoredTags(tags, meta, "access"))
+	assert.Same(t, tags, withoutIgnoredTags(tags, ignoreTagsSession{}, "user"))
+}
@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// dataSourcePrefix is the prefix of the names of the data sources in the problems
+const dataSourcePrefix = "(Data) "
+
+// Remediation is a hint that helps users resolve a problem, with the documentation of the hint
+type Remediation struct {
+	Hint             string
+	DocumentationURL string
+}
+
+// remediationRule is a remediation of the failed API calls that match all of its non-zero fields
+type remediationRule struct {
+	service    string
+	statusCode int
+	errorCode  string
+	operation  string
+	// dataSource matches the problems of the data sources when it is true, and of the resources
+	// when it is false, if the rule has an operation
+	dataSource bool
+
+	remediation Remediation
+}
+
+// specificity is the number of fields that the rule matches, where the most specific matching rule
+// is the remediation of a problem
+func (r remediationRule) specificity() int {
+	specificity := 0
+	for _, field := range []bool{r.service != "", r.statusCode != 0, r.errorCode != "", r.operation != ""} {
+		if field {
+			specificity++
+		}
+	}
+	return specificity
+}
+
+const (
+	iamAccessDocumentation = "https://cloud.ibm.com/docs/account?topic=account-assign-access-resources"
+	apiKeyDocumentation    = "https://cloud.ibm.com/docs/account?topic=account-userapikey"
+	refreshDocumentation   = "https://developer.hashicorp.com/terraform/cli/commands/plan#planning-modes"
+)
+
+// remediationCatalog is the catalog of the remediations by service, status code and error code
+var remediationCatalog = []remediationRule{
+	{
+		statusCode: 401,
+		remediation: Remediation{
+			Hint:             "The credential of the provider was rejected. Check that the ibmcloud_api_key or iam_token of the provider is valid and has not expired.",
+			DocumentationURL: apiKeyDocumentation,
+		},
+	},
+	{
+		statusCode: 403,
+		remediation: Remediation{
+			Hint:             "The credential of the provider is not authorized for the API operation. Assign the missing IAM role of the service to the user, service ID or trusted profile of the provider.",
+			DocumentationURL: iamAccessDocumentation,
+		},
+	},
+	{
+		service:    "vpc",
+		statusCode: 403,
+		remediation: Remediation{
+			Hint:             "The credential of the provider is not authorized for the API operation. Assign the missing IAM role of VPC Infrastructure Services, such as Editor for the resource and Viewer for the resources that it references, to the user, service ID or trusted profile of the provider.",
+			DocumentationURL: "https://cloud.ibm.com/docs/vpc?topic=vpc-iam-getting-started",
+		},
+	},
+	{
+		service:    "power",
+		statusCode: 403,
+		remediation: Remediation{
+			Hint:             "The credential of the provider is not authorized for the API operation. Assign the missing IAM role of the Power Virtual Server workspace, such as Manager, to the user, service ID or trusted profile of the provider.",
+			DocumentationURL: iamAccessDocumentation,
+		},
+	},
+	{
+		service:    "kubernetes",
+		statusCode: 403,
+		remediation: Remediation{
+			Hint:             "The credential of the provider is not authorized for the API operation. Assign the missing platform and service roles of Kubernetes Service, and the roles of the infrastructure that the cluster uses, to the user, service ID or trusted profile of the provider.",
+			DocumentationURL: "https://cloud.ibm.com/docs/containers?topic=containers-access_reference",
+		},
+	},
+	{
+		statusCode: 404,
+		remediation: Remediation{
+			Hint: "The resource or a resource that it references was not found. Check the IDs of the referenced resources, and the region and the endpoints of the provider.",
+		},
+	},
+	{
+		statusCode: 404,
+		operation:  "read",
+		remediation: Remediation{
+			Hint:             "The resource was not found, and may have been deleted outside of Terraform. Run terraform apply -refresh-only to review the drift, and remove the resource from the state with terraform state rm if it no longer exists.",
+			DocumentationURL: refreshDocumentation,
+		},
+	},
+	{
+		statusCode: 404,
+		operation:  "read",
+		dataSource: true,
+		remediation: Remediation{
+			Hint: "The object was not found. Check the name or ID in the arguments of the data source, and the region of the provider.",
+		},
+	},
+	{
+		statusCode: 409,
+		remediation: Remediation{
+			Hint: "The request conflicts with the state of the resource, which may be changed by another operation, or a resource with the same name may already exist. Wait for the other operation to finish, or choose a unique name, and apply again.",
+		},
+	},
+	{
+		statusCode: 412,
+		remediation: Remediation{
+			Hint: "The resource was changed since it was read. Apply again to make the change from its current state.",
+		},
+	},
+	{
+		statusCode: 429,
+		remediation: Remediation{
+			Hint: "The rate limit of the API or a quota of the account was exceeded. Lower the parallelism of terraform apply, or the rate_limits of the provider, and apply again, or request a higher quota.",
+		},
+	},
+	{
+		service:    "vpc",
+		statusCode: 429,
+		remediation: Remediation{
+			Hint:             "The rate limit of the VPC API or a quota of the account was exceeded. Lower the parallelism of terraform apply, or the vpc rate limit of the provider, and apply again, or request a higher quota.",
+			DocumentationURL: "https://cloud.ibm.com/docs/vpc?topic=vpc-quotas",
+		},
+	},
+	{
+		errorCode: "quota_exceeded",
+		remediation: Remediation{
+			Hint: "A quota of the account was exceeded. Delete the resources that are no longer used, or request a higher quota.",
+		},
+	},
+	{
+		service:   "vpc",
+		errorCode: "over_quota",
+		remediation: Remediation{
+			Hint:             "A quota of the VPC resources of the account was exceeded. Delete the resources that are no longer used, or request a higher quota.",
+			DocumentationURL: "https://cloud.ibm.com/docs/vpc?topic=vpc-quotas",
+		},
+	},
+}
+
+// resourceServices are the services of the resources by the prefix of their names
+var resourceServices = []struct {
+	prefix  string
+	service string
+}{
+	{"ibm_is_", "vpc"},
+	{"ibm_pi_", "power"},
+	{"ibm_container_", "kubernetes"},
+	{"ibm_cis", "cis"},
+	{"ibm_iam_", "iam"},
+	{"ibm_resource_", "resource_controller"},
+}
+
+// ResourceService returns the service of the resource or data source in the remediation catalog, or
+// an empty string for the other services
+func ResourceService(resource string) string {
+	resource = strings.TrimPrefix(resource, dataSourcePrefix)
+	for _, resourceService := range resourceServices {
+		if strings.HasPrefix(resource, resourceService.prefix) {
+			return resourceService.service
+		}
+	}
+	return ""
+}
+
+// LookupRemediation returns the most specific remediation of the failed API call of the service, or
+// nil when there is none
+func LookupRemediation(service string, statusCode int, errorCode, operation string, dataSource bool) *Remediation {
+	var match *remediationRule
+	for i, rule := range remediationCatalog {
+		if (rule.service != "" && rule.service != service) ||
+			(rule.statusCode != 0 && rule.statusCode != statusCode) ||
+			(rule.errorCode != "" && !strings.EqualFold(rule.errorCode, errorCode)) ||
+			(rule.operation != "" && (rule.operation != operation || rule.dataSource != dataSource)) {
+			continue
+		}
+		if match == nil || rule.specificity() > match.specificity() {
+			match = &remediationCatalog[i]
+		}
+	}
+	if match == nil {
+		return nil
+	}
+	remediation := match.remediation
+	return &remediation
+}
+
+// apiFailure is the failed API call that caused a problem
+type apiFailure struct {
+	operationID string
+	statusCode  int
+	errorCode   string
+}
+
+// getAPIFailure returns the failed API call that caused the problem, from the HTTP problem of the
+// IBM Cloud SDKs or the request failure of bluemix-go, or nil when the problem was not caused by
+// an API call
+func (e *TerraformProblem) getAPIFailure() *apiFailure {
+	if e.IBMProblem == nil {
+		return nil
+	}
+	var httpProblem *core.HTTPProblem
+	if errors.As(e, &httpProblem) && httpProblem.Response != nil {
+		failure := &apiFailure{
+			operationID: httpProblem.OperationID,
+			statusCode:  httpProblem.Response.GetStatusCode(),
+		}
+		if result, ok := httpProblem.Response.Result.(map[string]interface{}); ok {
+			failure.errorCode = responseErrorCode(result)
+		}
+		return failure
+	}
+	var requestFailure bmxerror.RequestFailure
+	if errors.As(e, &requestFailure) {
+		return &apiFailure{
+			statusCode: requestFailure.StatusCode(),
+			errorCode:  requestFailure.Code(),
+		}
+	}
+	return nil
+}
+
+// responseErrorCode returns the error code of the body of an error response
+func responseErrorCode(result map[string]interface{}) string {
+	if errs, ok := result["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			if code, ok := first["code"].(string); ok {
+				return code
+			}
+		}
+	}
+	for _, key := range []string{"code", "errorCode"} {
+		if code, ok := result[key].(string); ok {
+			return code
+		}
+	}
+	return ""
+}
+
+// GetRemediation returns the remediation of the failed API call that caused the problem, or nil when
+// there is none
+func (e *TerraformProblem) GetRemediation() *Remediation {
+	failure := e.getAPIFailure()
+	if failure == nil {
+		return nil
+	}
+	return LookupRemediation(ResourceService(e.Resource), failure.statusCode, failure.errorCode, e.Operation, strings.HasPrefix(e.Resource, dataSourcePrefix))
+}
+
+// GetDetail returns the detail of the diagnostic of the problem, with the resource, the failed API
+// operation and the remediation of the problem
+func (e *TerraformProblem) GetDetail() string {
+	var lines []string
+	if e.Resource != "" {
+		lines = append(lines, fmt.Sprintf("Resource: %s", e.Resource))
+	}
+	if e.Operation != "" {
+		lines = append(lines, fmt.Sprintf("Operation: %s", e.Operation))
+	}
+	if failure := e.getAPIFailure(); failure != nil {
+		apiOperation := failure.operationID
+		if apiOperation == "" {
+			apiOperation = "unknown"
+		}
+		apiFailure := fmt.Sprintf("API operation: %s failed with status code %d", apiOperation, failure.statusCode)
+		if failure.errorCode != "" {
+			apiFailure += fmt.Sprintf(" and error code %s", failure.errorCode)
+		}
+		lines = append(lines, apiFailure)
+	}
+	if remediation := e.GetRemediation(); remediation != nil {
+		lines = append(lines, fmt.Sprintf("Hint: %s", remediation.Hint))
+		if remediation.DocumentationURL != "" {
+			lines = append(lines, fmt.Sprintf("Documentation: %s", remediation.DocumentationURL))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// AddResourceID adds the ID of the resource to the resource of the details of the error diagnostics,
+// so that they name the instance of the resource that failed
+func AddResourceID(diags diag.Diagnostics, id string) diag.Diagnostics {
+	if id == "" {
+		return diags
+	}
+	for i, d := range diags {
+		if d.Severity != diag.Error || !strings.HasPrefix(d.Detail, "Resource: ") {
+			continue
+		}
+		resource, rest, _ := strings.Cut(d.Detail, "\n")
+		if strings.Contains(resource, " (ID: ") {
+			continue
+		}
+		diags[i].Detail = fmt.Sprintf("%s (ID: %s)", resource, id)
+		if rest != "" {
+			diags[i].Detail += "\n" + rest
+		}
+	}
+	return diags
+}
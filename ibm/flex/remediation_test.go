@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+// getHTTPProblem returns the problem of a failed API call of the IBM Cloud SDKs
+func getHTTPProblem(operationID string, statusCode int, errorCode string) *core.SDKProblem {
+	httpProblem := &core.HTTPProblem{
+		IBMProblem:  core.IBMErrorf(nil, core.NewProblemComponent("vpc", "1.0.0"), "Request failed.", ""),
+		OperationID: operationID,
+		Response: &core.DetailedResponse{
+			StatusCode: statusCode,
+			Result: map[string]interface{}{
+				"errors": []interface{}{map[string]interface{}{"code": errorCode}},
+			},
+		},
+	}
+	return core.SDKErrorf(httpProblem, "Request failed.", "", core.NewProblemComponent("vpc", "1.0.0"))
+}
+
+func TestLookupRemediation(t *testing.T) {
+	assert.Nil(t, LookupRemediation("vpc", 400, "validation_invalid_name", "create", false))
+
+	// the most specific remediation is returned
+	assert.Contains(t, LookupRemediation("", 403, "", "create", false).Hint, "IAM role of the service")
+	assert.Contains(t, LookupRemediation("vpc", 403, "", "create", false).Hint, "VPC Infrastructure Services")
+	assert.Contains(t, LookupRemediation("vpc", 404, "not_found", "update", false).Hint, "references was not found")
+	assert.Contains(t, LookupRemediation("vpc", 404, "not_found", "read", false).Hint, "deleted outside of Terraform")
+	assert.Contains(t, LookupRemediation("vpc", 404, "not_found", "read", true).Hint, "arguments of the data source")
+	assert.Contains(t, LookupRemediation("", 409, "", "delete", false).Hint, "conflicts")
+	assert.Contains(t, LookupRemediation("power", 429, "", "create", false).Hint, "rate_limits")
+	assert.Contains(t, LookupRemediation("vpc", 400, "over_quota", "create", false).Hint, "quota of the VPC resources")
+	assert.Contains(t, LookupRemediation("", 400, "QUOTA_EXCEEDED", "create", false).Hint, "quota of the account")
+}
+
+func TestResourceService(t *testing.T) {
+	assert.Equal(t, "vpc", ResourceService("ibm_is_vpc"))
+	assert.Equal(t, "vpc", ResourceService("(Data) ibm_is_instance"))
+	assert.Equal(t, "power", ResourceService("ibm_pi_instance"))
+	assert.Equal(t, "", ResourceService("ibm_cos_bucket"))
+}
+
+func TestTerraformProblemRemediation(t *testing.T) {
+	terraformProb := TerraformErrorf(getHTTPProblem("create_vpc", 403, "not_authorized"), "CreateVPCWithContext failed.", "ibm_is_vpc", "create")
+
+	remediation := terraformProb.GetRemediation()
+	assert.NotNil(t, remediation)
+	assert.Contains(t, terraformProb.GetConsoleMessage(), "hint: The credential of the provider is not authorized")
+	assert.Contains(t, terraformProb.GetConsoleMessage(), "documentation: "+remediation.DocumentationURL)
+
+	detail := terraformProb.GetDetail()
+	assert.Equal(t, strings.Join([]string{
+		"Resource: ibm_is_vpc",
+		"Operation: create",
+		"API operation: create_vpc failed with status code 403 and error code not_authorized",
+		"Hint: " + remediation.Hint,
+		"Documentation: " + remediation.DocumentationURL,
+	}, "\n"), detail)
+
+	diagnostics := terraformProb.GetDiag()
+	assert.Len(t, diagnostics, 1)
+	assert.Equal(t, detail, diagnostics[0].Detail)
+}
+
+func TestTerraformProblemRemediationRequestFailure(t *testing.T) {
+	terraformProb := TerraformErrorf(bmxerror.NewRequestFailure("Conflict", "The cluster is being deployed", 409), "Update failed.", "ibm_container_cluster", "update")
+	assert.Contains(t, terraformProb.GetRemediation().Hint, "conflicts")
+	assert.Contains(t, terraformProb.GetDetail(), "API operation: unknown failed with status code 409 and error code Conflict")
+}
+
+func TestTerraformProblemWithoutRemediation(t *testing.T) {
+	terraformProb := getPopulatedTerraformProblem()
+	assert.Nil(t, terraformProb.GetRemediation())
+	assert.Equal(t, "Resource: ibm_some_resource\nOperation: create", terraformProb.GetDetail())
+}
+
+func TestAddResourceID(t *testing.T) {
+	diags := AddResourceID(diag.Diagnostics{
+		{Severity: diag.Error, Summary: "Create failed.", Detail: "Resource: ibm_is_vpc\nOperation: create"},
+		{Severity: diag.Error, Summary: "Create failed."},
+		{Severity: diag.Warning, Summary: "Deprecated.", Detail: "Resource: ibm_is_vpc"},
+	}, "r006-1234")
+	assert.Equal(t, "Resource: ibm_is_vpc (ID: r006-1234)\nOperation: create", diags[0].Detail)
+	assert.Equal(t, "", diags[1].Detail)
+	assert.Equal(t, "Resource: ibm_is_vpc", diags[2].Detail)
+
+	// the ID is added once
+	assert.Equal(t, "Resource: ibm_is_vpc (ID: r006-1234)\nOperation: create", AddResourceID(diags, "r006-1234")[0].Detail)
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	identityRegion           = "region"
+	identityDefaultSeparator = "/"
+)
+
+// CompositeIdentity describes a resource identity whose attributes are the parts of the
+// Terraform ID of the resource, such as the `lbID/poolID/memberID` ID of a load balancer pool member.
+type CompositeIdentity struct {
+	// Attributes are the names of the identity attributes, in the order in which they appear in the ID.
+	Attributes []string
+
+	// Separator joins the attributes in the ID. Defaults to "/".
+	Separator string
+
+	// Regional adds an optional "region" attribute, set to the region of the provider.
+	Regional bool
+}
+
+func (ci CompositeIdentity) separator() string {
+	if ci.Separator == "" {
+		return identityDefaultSeparator
+	}
+	return ci.Separator
+}
+
+// ResourceIdentity returns the identity schema of the resource.
+func (ci CompositeIdentity) ResourceIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			identitySchema := map[string]*schema.Schema{}
+			for _, attribute := range ci.Attributes {
+				identitySchema[attribute] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       identityAttributeDescription(attribute),
+				}
+			}
+			if ci.Regional {
+				identitySchema[identityRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The region of the resource. Defaults to the region of the provider.",
+				}
+			}
+			return identitySchema
+		},
+	}
+}
+
+func identityAttributeDescription(attribute string) string {
+	if attribute == "id" {
+		return "The ID of the resource."
+	}
+	parent := strings.ReplaceAll(strings.TrimSuffix(attribute, "_id"), "_", " ")
+	return fmt.Sprintf("The ID of the parent %s.", parent)
+}
+
+// Apply adds the identity to the resource. The identity is set from the ID of the resource after
+// every create, read and update, and the ID is built from the identity when importing by identity.
+func (ci CompositeIdentity) Apply(resource *schema.Resource) *schema.Resource {
+	resource.Identity = ci.ResourceIdentity()
+
+	resource.CreateContext = ci.wrapContextFunc(resource.CreateContext)
+	resource.ReadContext = ci.wrapContextFunc(resource.ReadContext)
+	resource.UpdateContext = ci.wrapContextFunc(resource.UpdateContext)
+	resource.CreateWithoutTimeout = ci.wrapContextFunc(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = ci.wrapContextFunc(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = ci.wrapContextFunc(resource.UpdateWithoutTimeout)
+	resource.Create = ci.wrapFunc(resource.Create)
+	resource.Read = ci.wrapFunc(resource.Read)
+	resource.Update = ci.wrapFunc(resource.Update)
+
+	if resource.Importer != nil {
+		resource.Importer = &schema.ResourceImporter{
+			StateContext: ci.wrapImporter(resource.Importer),
+		}
+	}
+	return resource
+}
+
+func (ci CompositeIdentity) wrapContextFunc(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		if err := ci.SetIdentity(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func (ci CompositeIdentity) wrapFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		return ci.SetIdentity(d, meta)
+	}
+}
+
+func (ci CompositeIdentity) wrapImporter(importer *schema.ResourceImporter) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			if err := ci.setIDFromIdentity(d, meta); err != nil {
+				return nil, err
+			}
+		}
+
+		switch {
+		case importer.StateContext != nil:
+			return importer.StateContext(ctx, d, meta)
+		case importer.State != nil:
+			return importer.State(d, meta)
+		default:
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+}
+
+// SetIdentity sets the identity attributes from the parts of the ID of the resource.
+// It does nothing when the resource is gone.
+func (ci CompositeIdentity) SetIdentity(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	parts := strings.SplitN(d.Id(), ci.separator(), len(ci.Attributes))
+	if len(parts) != len(ci.Attributes) {
+		return fmt.Errorf("Unexpected format of ID (%s), expected %s", d.Id(), strings.Join(ci.Attributes, ci.separator()))
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for i, attribute := range ci.Attributes {
+		if err := identity.Set(attribute, parts[i]); err != nil {
+			return err
+		}
+	}
+	if ci.Regional {
+		if err := identity.Set(identityRegion, providerRegion(meta)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setIDFromIdentity sets the ID of the resource being imported from its identity.
+func (ci CompositeIdentity) setIDFromIdentity(d *schema.ResourceData, meta interface{}) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+
+	parts := make([]string, 0, len(ci.Attributes))
+	for _, attribute := range ci.Attributes {
+		value, ok := identity.GetOk(attribute)
+		if !ok {
+			return fmt.Errorf("Expected identity to contain %q", attribute)
+		}
+		parts = append(parts, value.(string))
+	}
+
+	if ci.Regional {
+		if region, ok := identity.GetOk(identityRegion); ok {
+			if current := providerRegion(meta); current != "" && region.(string) != current {
+				return fmt.Errorf("The identity region %q does not match the provider region %q. Import the resource with a provider configured for region %q", region, current, region)
+			}
+		}
+	}
+
+	d.SetId(strings.Join(parts, ci.separator()))
+	return nil
+}
+
+func providerRegion(meta interface{}) string {
+	session, ok := meta.(conns.ClientSession)
+	if !ok {
+		return ""
+	}
+	bmxSess, err := session.BluemixSession()
+	if err != nil || bmxSess == nil || bmxSess.Config == nil {
+		return ""
+	}
+	return bmxSess.Config.Region
+}
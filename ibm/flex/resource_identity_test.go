@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCompositeIdentityResource(ci CompositeIdentity) *schema.Resource {
+	return ci.Apply(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return nil
+		},
+		Importer: &schema.ResourceImporter{},
+	})
+}
+
+func TestCompositeIdentitySetFromID(t *testing.T) {
+	ci := CompositeIdentity{Attributes: []string{"lb_id", "pool_id", "id"}, Regional: true}
+	resource := testCompositeIdentityResource(ci)
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaMap(), nil)
+	d.SetId("lb1/pool1/member1")
+	diags := resource.ReadContext(context.Background(), d, nil)
+	assert.False(t, diags.HasError())
+
+	identity, err := d.Identity()
+	assert.Nil(t, err)
+	assert.Equal(t, "lb1", identity.Get("lb_id"))
+	assert.Equal(t, "pool1", identity.Get("pool_id"))
+	assert.Equal(t, "member1", identity.Get("id"))
+}
+
+func TestCompositeIdentityLastPartKeepsSeparator(t *testing.T) {
+	ci := CompositeIdentity{Attributes: []string{"vpn_gateway_id", "cidr"}}
+	resource := testCompositeIdentityResource(ci)
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaMap(), nil)
+	d.SetId("gateway1/10.0.0.0/24")
+	assert.False(t, resource.ReadContext(context.Background(), d, nil).HasError())
+
+	identity, _ := d.Identity()
+	assert.Equal(t, "gateway1", identity.Get("vpn_gateway_id"))
+	assert.Equal(t, "10.0.0.0/24", identity.Get("cidr"))
+}
+
+func TestCompositeIdentityMalformedID(t *testing.T) {
+	ci := CompositeIdentity{Attributes: []string{"security_group_id", "id"}, Separator: "."}
+	resource := testCompositeIdentityResource(ci)
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaMap(), nil)
+	d.SetId("group1/rule1")
+	assert.True(t, resource.ReadContext(context.Background(), d, nil).HasError())
+}
+
+func TestCompositeIdentityImport(t *testing.T) {
+	ci := CompositeIdentity{Attributes: []string{"security_group_id", "id"}, Separator: ".", Regional: true}
+	resource := testCompositeIdentityResource(ci)
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaMap(), map[string]string{
+		"security_group_id": "group1",
+		"id":                "rule1",
+		"region":            "us-south",
+	})
+	d.SetId("")
+	result, err := resource.Importer.StateContext(context.Background(), d, nil)
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "group1.rule1", result[0].Id())
+}
+
+func TestCompositeIdentityImportMissingAttribute(t *testing.T) {
+	ci := CompositeIdentity{Attributes: []string{"lb_id", "id"}}
+	resource := testCompositeIdentityResource(ci)
+
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaMap(), map[string]string{
+		"id": "pool1",
+	})
+	d.SetId("")
+	_, err := resource.Importer.StateContext(context.Background(), d, nil)
+	assert.NotNil(t, err)
+}
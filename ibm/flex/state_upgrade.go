@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// StateUpgrade is a change of the raw state of a resource from a schema version to the next, such as
+// the rename of an attribute
+type StateUpgrade func(rawState map[string]interface{}) error
+
+// NewStateUpgrader returns the upgrader of the state of the schema version to the next version, which
+// applies the upgrades in order. The prior schema is the schema of the version, which PriorSchema
+// returns from the current schema and the attributes that the next version retired.
+func NewStateUpgrader(version int, prior map[string]*schema.Schema, upgrades ...StateUpgrade) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    (&schema.Resource{Schema: prior}).CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				return rawState, nil
+			}
+			for _, upgrade := range upgrades {
+				if err := upgrade(rawState); err != nil {
+					return nil, fmt.Errorf("[ERROR] Error upgrading the state of schema version %d: %s", version, err)
+				}
+			}
+			return rawState, nil
+		},
+	}
+}
+
+// PriorSchema returns a copy of the schema with the retired attributes, by the path of their name
+// and the blocks that contain them, such as "primary_network_interface.primary_ipv4_address". The
+// current schema is not changed.
+func PriorSchema(current map[string]*schema.Schema, retired map[string]*schema.Schema) map[string]*schema.Schema {
+	prior := copySchemaMap(current)
+	for path, attribute := range retired {
+		addSchemaAttribute(prior, strings.Split(path, "."), attribute)
+	}
+	return prior
+}
+
+func copySchemaMap(schemaMap map[string]*schema.Schema) map[string]*schema.Schema {
+	copied := make(map[string]*schema.Schema, len(schemaMap))
+	for name, attribute := range schemaMap {
+		copied[name] = attribute
+	}
+	return copied
+}
+
+// addSchemaAttribute adds the attribute at the path to the schema, copying the blocks on the path
+func addSchemaAttribute(schemaMap map[string]*schema.Schema, path []string, attribute *schema.Schema) {
+	if len(path) == 1 {
+		schemaMap[path[0]] = attribute
+		return
+	}
+	block, ok := schemaMap[path[0]]
+	if !ok {
+		panic(fmt.Sprintf("block %q of the prior schema not found", path[0]))
+	}
+	elem, ok := block.Elem.(*schema.Resource)
+	if !ok {
+		panic(fmt.Sprintf("attribute %q of the prior schema is not a block", path[0]))
+	}
+	copiedBlock := *block
+	copiedElem := *elem
+	copiedElem.Schema = copySchemaMap(elem.Schema)
+	copiedBlock.Elem = &copiedElem
+	schemaMap[path[0]] = &copiedBlock
+	addSchemaAttribute(copiedElem.Schema, path[1:], attribute)
+}
+
+// stateObjects returns the objects of the raw state at the path of blocks, which are the elements of
+// all the blocks on the path. The raw state is the only object of an empty path.
+func stateObjects(rawState map[string]interface{}, blocks []string) []map[string]interface{} {
+	objects := []map[string]interface{}{rawState}
+	for _, block := range blocks {
+		var elements []map[string]interface{}
+		for _, object := range objects {
+			items, _ := object[block].([]interface{})
+			for _, item := range items {
+				if element, ok := item.(map[string]interface{}); ok {
+					elements = append(elements, element)
+				}
+			}
+		}
+		objects = elements
+	}
+	return objects
+}
+
+// splitStatePath returns the blocks and the name of the attribute of the path
+func splitStatePath(path string) ([]string, string) {
+	parts := strings.Split(path, ".")
+	return parts[:len(parts)-1], parts[len(parts)-1]
+}
+
+// isEmptyStateValue returns whether the value of the raw state is unset
+func isEmptyStateValue(value interface{}) bool {
+	if value == nil || value == "" {
+		return true
+	}
+	if items, ok := value.([]interface{}); ok {
+		return len(items) == 0
+	}
+	return false
+}
+
+// RenameStateAttribute returns the upgrade that renames the attribute at the path, such as
+// "network_interfaces.primary_ipv4_address", to the name in the same object. The value of the
+// attribute with the new name is kept when it is set.
+func RenameStateAttribute(path, name string) StateUpgrade {
+	blocks, from := splitStatePath(path)
+	return func(rawState map[string]interface{}) error {
+		for _, object := range stateObjects(rawState, blocks) {
+			value, ok := object[from]
+			if !ok {
+				continue
+			}
+			delete(object, from)
+			if isEmptyStateValue(object[name]) {
+				object[name] = value
+			}
+		}
+		return nil
+	}
+}
+
+// StateListToSet returns the upgrade of the list at the path to a set, which removes the duplicate
+// elements of the list
+func StateListToSet(path string) StateUpgrade {
+	blocks, name := splitStatePath(path)
+	return func(rawState map[string]interface{}) error {
+		for _, object := range stateObjects(rawState, blocks) {
+			value, ok := object[name]
+			if !ok || value == nil {
+				continue
+			}
+			items, ok := value.([]interface{})
+			if !ok {
+				return fmt.Errorf("%s is a %T, not a list", path, value)
+			}
+			set := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				duplicate := false
+				for _, element := range set {
+					if reflect.DeepEqual(element, item) {
+						duplicate = true
+						break
+					}
+				}
+				if !duplicate {
+					set = append(set, item)
+				}
+			}
+			object[name] = set
+		}
+		return nil
+	}
+}
+
+// StateAttributeToBlock returns the upgrade that moves the string, or other primitive value, of the
+// attribute at the path to the attribute of the block with a single element in the same object, such
+// as "availability_policy_host_failure" to "availability_policy.0.host_failure". The block is added
+// when it is not set, and the value of the attribute of the block is kept when it is set.
+func StateAttributeToBlock(path, block, attribute string) StateUpgrade {
+	blocks, from := splitStatePath(path)
+	return func(rawState map[string]interface{}) error {
+		for _, object := range stateObjects(rawState, blocks) {
+			value, ok := object[from]
+			if !ok {
+				continue
+			}
+			delete(object, from)
+			if isEmptyStateValue(value) {
+				continue
+			}
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				return fmt.Errorf("%s is a %T, not a primitive value", path, value)
+			}
+			items, _ := object[block].([]interface{})
+			if len(items) == 0 {
+				object[block] = []interface{}{map[string]interface{}{attribute: value}}
+				continue
+			}
+			element, ok := items[0].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s is not a block", block)
+			}
+			if isEmptyStateValue(element[attribute]) {
+				element[attribute] = value
+			}
+		}
+		return nil
+	}
+}
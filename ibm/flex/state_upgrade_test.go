@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testStateUpgradeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+		"policy": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host_failure": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+		"interfaces": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+		"tags": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+}
+
+func TestPriorSchema(t *testing.T) {
+	current := testStateUpgradeSchema()
+	retired := &schema.Schema{Type: schema.TypeString, Optional: true}
+	prior := PriorSchema(current, map[string]*schema.Schema{
+		"policy_host_failure":  retired,
+		"interfaces.ipv4_addr": retired,
+	})
+
+	assert.Same(t, retired, prior["policy_host_failure"])
+	assert.Same(t, retired, prior["interfaces"].Elem.(*schema.Resource).Schema["ipv4_addr"])
+	assert.Same(t, current["name"], prior["name"])
+	assert.NotContains(t, current, "policy_host_failure")
+	assert.NotContains(t, current["interfaces"].Elem.(*schema.Resource).Schema, "ipv4_addr")
+}
+
+func TestNewStateUpgrader(t *testing.T) {
+	current := testStateUpgradeSchema()
+	prior := PriorSchema(current, map[string]*schema.Schema{
+		"policy_host_failure":  {Type: schema.TypeString, Optional: true},
+		"interfaces.ipv4_addr": {Type: schema.TypeString, Optional: true},
+	})
+	resource := &schema.Resource{
+		Schema:        current,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			NewStateUpgrader(0, prior,
+				StateAttributeToBlock("policy_host_failure", "policy", "host_failure"),
+				RenameStateAttribute("interfaces.ipv4_addr", "address"),
+				StateListToSet("tags"),
+			),
+		},
+	}
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	rawState, err := resource.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{
+		"name":                "instance1",
+		"policy_host_failure": "restart",
+		"interfaces": []interface{}{
+			map[string]interface{}{"ipv4_addr": "10.0.0.4"},
+			map[string]interface{}{"ipv4_addr": "10.0.0.5", "address": "10.0.0.6"},
+		},
+		"tags": []interface{}{"env:dev", "env:dev", "team:vpc"},
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"name":   "instance1",
+		"policy": []interface{}{map[string]interface{}{"host_failure": "restart"}},
+		"interfaces": []interface{}{
+			map[string]interface{}{"address": "10.0.0.4"},
+			map[string]interface{}{"address": "10.0.0.6"},
+		},
+		"tags": []interface{}{"env:dev", "team:vpc"},
+	}, rawState)
+
+	rawState, err = resource.StateUpgraders[0].Upgrade(context.Background(), nil, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, rawState)
+}
+
+func TestStateAttributeToBlock(t *testing.T) {
+	upgrade := StateAttributeToBlock("enabled", "metadata_service", "enabled")
+
+	// the value of the block is kept
+	rawState := map[string]interface{}{
+		"enabled":          false,
+		"metadata_service": []interface{}{map[string]interface{}{"enabled": true, "protocol": "https"}},
+	}
+	assert.Nil(t, upgrade(rawState))
+	assert.Equal(t, map[string]interface{}{
+		"metadata_service": []interface{}{map[string]interface{}{"enabled": true, "protocol": "https"}},
+	}, rawState)
+
+	// the value is added to the block
+	rawState = map[string]interface{}{
+		"enabled":          true,
+		"metadata_service": []interface{}{map[string]interface{}{"protocol": "https"}},
+	}
+	assert.Nil(t, upgrade(rawState))
+	assert.Equal(t, []interface{}{map[string]interface{}{"enabled": true, "protocol": "https"}}, rawState["metadata_service"])
+
+	// an unset value does not add the block
+	rawState = map[string]interface{}{"enabled": nil}
+	assert.Nil(t, upgrade(rawState))
+	assert.Equal(t, map[string]interface{}{}, rawState)
+
+	assert.NotNil(t, upgrade(map[string]interface{}{"enabled": []interface{}{true}}))
+}
+
+func TestStateListToSetNotList(t *testing.T) {
+	assert.NotNil(t, StateListToSet("tags")(map[string]interface{}{"tags": "env:dev"}))
+	assert.Nil(t, StateListToSet("tags")(map[string]interface{}{"tags": nil}))
+}
@@ -67,6 +67,8 @@ const (
 	ResourceGroupName = "resource_group_name"
 	//DeletionProtection ...
 	DeletionProtection = "deletion_protection"
+	//TagsAll ...
+	TagsAll = "tags_all"
 	//RelatedCRN ...
 	RelatedCRN                                = "related_crn"
 	SystemIBMLabelPrefix                      = "ibm-cloud.kubernetes.io/"
@@ -2646,18 +2648,45 @@ func Parse(s string) (CRN, error) {
 
 	return crn, nil
 }
+
+// String returns the CRN in its canonical colon-separated form.
+func (c CRN) String() string {
+	scheme := c.Scheme
+	if scheme == "" {
+		scheme = crn
+	}
+	scope := c.Scope
+	if c.ScopeType != "" {
+		scope = c.ScopeType + scopeSeparator + c.Scope
+	}
+	return strings.Join([]string{
+		scheme,
+		c.Version,
+		c.CName,
+		c.CType,
+		c.ServiceName,
+		c.Region,
+		scope,
+		c.ServiceInstance,
+		c.ResourceType,
+		c.Resource,
+	}, crnSeparator)
+}
+
+// Location returns the location of the CRN, prefixed with the cloud name for clouds other than bluemix and staging.
+func (c CRN) Location() string {
+	if c.CName == "bluemix" || c.CName == "staging" {
+		return c.Region
+	}
+	return c.CName + "-" + c.Region
+}
+
 func GetLocationV2(instance rc.ResourceInstance) string {
 	crn, err := Parse(*instance.CRN)
 	if err != nil {
 		log.Fatal(err)
 	}
-	region := crn.Region
-	cName := crn.CName
-	if cName == "bluemix" || cName == "staging" {
-		return region
-	} else {
-		return cName + "-" + region
-	}
+	return crn.Location()
 }
 
 func GetTags(d *schema.ResourceData, meta interface{}) error {
@@ -2762,7 +2791,7 @@ func GetTagsUsingResourceCRNFromTaggingApi(meta interface{}, resourceID, resourc
 	for _, item := range taggingResult.Items {
 		taglist = append(taglist, *item.Name)
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return withoutIgnoredTags(NewStringSet(ResourceIBMVPCHash, taglist), meta, tagType), nil
 }
 
 func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tagType string) (*schema.Set, error) {
@@ -2811,7 +2840,7 @@ func GetGlobalTagsUsingSearchAPI(meta interface{}, resourceID, resourceType, tag
 			}
 		}
 	}
-	return NewStringSet(ResourceIBMVPCHash, taglist), nil
+	return withoutIgnoredTags(NewStringSet(ResourceIBMVPCHash, taglist), meta, tagType), nil
 }
 
 func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, resourceID, resourceType, tagType string) error {
@@ -2836,8 +2865,7 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 	if newList == nil {
 		newList = new(schema.Set)
 	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	olds, news := withoutIgnoredTags(oldList.(*schema.Set), meta, tagType), withoutIgnoredTags(newList.(*schema.Set), meta, tagType)
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
@@ -2986,8 +3014,7 @@ func UpdateTagsUsingCRN(oldList, newList interface{}, meta interface{}, resource
 	if newList == nil {
 		newList = new(schema.Set)
 	}
-	olds := oldList.(*schema.Set)
-	news := newList.(*schema.Set)
+	olds, news := withoutIgnoredTags(oldList.(*schema.Set), meta, ""), withoutIgnoredTags(newList.(*schema.Set), meta, "")
 	removeInt := olds.Difference(news).List()
 	addInt := news.Difference(olds).List()
 	add := make([]string, len(addInt))
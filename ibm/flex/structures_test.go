@@ -84,3 +84,23 @@ func TestStringifyMap(t *testing.T) {
 	var foo interface{} = map[string]interface{}{"foo": "bar"}
 	assert.Equal(t, `{"foo":"bar"}`, Stringify(foo))
 }
+
+func TestCRNString(t *testing.T) {
+	for _, s := range []string{
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/1234567890abcdef:1e2f3a4b-0000-1111-2222-333344445555:bucket:mybucket",
+		"crn:v1:bluemix:public:iam::global:::",
+		"crn:v1:staging:public:databases-for-redis:us-south:a/1234:5678::",
+	} {
+		crn, err := Parse(s)
+		assert.Nil(t, err)
+		assert.Equal(t, s, crn.String())
+	}
+}
+
+func TestCRNLocation(t *testing.T) {
+	crn := CRN{CName: "bluemix", Region: "us-south"}
+	assert.Equal(t, "us-south", crn.Location())
+
+	crn = CRN{CName: "mycloud", Region: "eu-de"}
+	assert.Equal(t, "mycloud-eu-de", crn.Location())
+}
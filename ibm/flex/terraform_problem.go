@@ -44,6 +44,12 @@ func (e *TerraformProblem) GetConsoleOrderedMaps() *core.OrderedMaps {
 	orderedMaps.Add("severity", e.Severity)
 	orderedMaps.Add("resource", e.Resource)
 	orderedMaps.Add("operation", e.Operation)
+	if remediation := e.GetRemediation(); remediation != nil {
+		orderedMaps.Add("hint", remediation.Hint)
+		if remediation.DocumentationURL != "" {
+			orderedMaps.Add("documentation", remediation.DocumentationURL)
+		}
+	}
 	orderedMaps.Add("component", e.Component)
 
 	return orderedMaps
@@ -61,10 +67,18 @@ func (e *TerraformProblem) GetDebugOrderedMaps() *core.OrderedMaps {
 }
 
 // GetDiag returns a new Diagnostics object using the console
-// message as the summary. It is used to create a Diagnostics
-// object from a TerraformProblem in the resource/data source code.
+// message as the summary, and the resource, the failed API
+// operation and the remediation of the problem as the detail.
+// It is used to create a Diagnostics object from a
+// TerraformProblem in the resource/data source code.
 func (e *TerraformProblem) GetDiag() diag.Diagnostics {
-	return diag.Errorf("%s", e.GetConsoleMessage())
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  e.GetConsoleMessage(),
+			Detail:   e.GetDetail(),
+		},
+	}
 }
 
 // TerraformErrorf creates and returns a new instance of `TerraformProblem`
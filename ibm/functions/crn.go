@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// crnAttributeTypes describes the object used to represent a CRN in provider functions.
+var crnAttributeTypes = map[string]attr.Type{
+	"version":          types.StringType,
+	"cname":            types.StringType,
+	"ctype":            types.StringType,
+	"service_name":     types.StringType,
+	"region":           types.StringType,
+	"scope_type":       types.StringType,
+	"scope":            types.StringType,
+	"service_instance": types.StringType,
+	"resource_type":    types.StringType,
+	"resource":         types.StringType,
+}
+
+// crnAttributesDescription documents the attributes of the CRN object.
+const crnAttributesDescription = "The object has the attributes `version`, `cname`, `ctype`, `service_name`, `region`, " +
+	"`scope_type`, `scope`, `service_instance`, `resource_type` and `resource`. `scope_type` is the scope prefix, " +
+	"for example `a` for an account scope, and is empty for a `global` scope."
+
+type crnModel struct {
+	Version         types.String `tfsdk:"version"`
+	CName           types.String `tfsdk:"cname"`
+	CType           types.String `tfsdk:"ctype"`
+	ServiceName     types.String `tfsdk:"service_name"`
+	Region          types.String `tfsdk:"region"`
+	ScopeType       types.String `tfsdk:"scope_type"`
+	Scope           types.String `tfsdk:"scope"`
+	ServiceInstance types.String `tfsdk:"service_instance"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	Resource        types.String `tfsdk:"resource"`
+}
+
+func crnModelFromCRN(crn flex.CRN) crnModel {
+	return crnModel{
+		Version:         types.StringValue(crn.Version),
+		CName:           types.StringValue(crn.CName),
+		CType:           types.StringValue(crn.CType),
+		ServiceName:     types.StringValue(crn.ServiceName),
+		Region:          types.StringValue(crn.Region),
+		ScopeType:       types.StringValue(crn.ScopeType),
+		Scope:           types.StringValue(crn.Scope),
+		ServiceInstance: types.StringValue(crn.ServiceInstance),
+		ResourceType:    types.StringValue(crn.ResourceType),
+		Resource:        types.StringValue(crn.Resource),
+	}
+}
+
+// toCRN converts the model to a flex.CRN. Null attributes are treated as empty segments.
+func (m crnModel) toCRN() flex.CRN {
+	return flex.CRN{
+		Version:         m.Version.ValueString(),
+		CName:           m.CName.ValueString(),
+		CType:           m.CType.ValueString(),
+		ServiceName:     m.ServiceName.ValueString(),
+		Region:          m.Region.ValueString(),
+		ScopeType:       m.ScopeType.ValueString(),
+		Scope:           m.Scope.ValueString(),
+		ServiceInstance: m.ServiceInstance.ValueString(),
+		ResourceType:    m.ResourceType.ValueString(),
+		Resource:        m.Resource.ValueString(),
+	}
+}
+
+// parseCRN parses a CRN string, rejecting the empty string which flex.Parse accepts.
+func parseCRN(s string) (flex.CRN, error) {
+	if s == "" {
+		return flex.CRN{}, flex.ErrMalformedCRN
+	}
+	return flex.Parse(s)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &buildCRNFunction{}
+
+func NewBuildCRNFunction() function.Function {
+	return &buildCRNFunction{}
+}
+
+type buildCRNFunction struct{}
+
+func (f *buildCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_crn"
+}
+
+func (f *buildCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a Cloud Resource Name (CRN) from its segments",
+		Description: "Builds an IBM Cloud Resource Name (CRN) from an object with its segments, such as the one returned by `parse_crn`. " + crnAttributesDescription + " `version`, `cname`, `ctype` and `service_name` must not be empty.",
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "segments",
+				Description:    "The segments of the CRN.",
+				AttributeTypes: crnAttributeTypes,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var segments crnModel
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &segments))
+	if resp.Error != nil {
+		return
+	}
+
+	crn := segments.toCRN()
+	for _, segment := range []struct {
+		name     string
+		value    string
+		required bool
+	}{
+		{"version", crn.Version, true},
+		{"cname", crn.CName, true},
+		{"ctype", crn.CType, true},
+		{"service_name", crn.ServiceName, true},
+		{"region", crn.Region, false},
+		{"scope_type", crn.ScopeType, false},
+		{"scope", crn.Scope, false},
+		{"service_instance", crn.ServiceInstance, false},
+		{"resource_type", crn.ResourceType, false},
+		{"resource", crn.Resource, false},
+	} {
+		if segment.required && segment.value == "" {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("The %q segment of the CRN must not be empty.", segment.name)))
+		}
+		if strings.Contains(segment.value, ":") {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("The %q segment of the CRN must not contain \":\".", segment.name)))
+		}
+	}
+	if crn.ScopeType != "" && crn.Scope == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "The \"scope\" segment of the CRN must be set when \"scope_type\" is set."))
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crn.String()))
+}
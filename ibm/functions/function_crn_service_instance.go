@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &crnServiceInstanceFunction{}
+
+func NewCRNServiceInstanceFunction() function.Function {
+	return &crnServiceInstanceFunction{}
+}
+
+type crnServiceInstanceFunction struct{}
+
+func (f *crnServiceInstanceFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "crn_service_instance"
+}
+
+func (f *crnServiceInstanceFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extract the service instance from a Cloud Resource Name (CRN)",
+		Description: "Returns the service instance segment of an IBM Cloud Resource Name (CRN), which is typically the GUID of the service instance.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN from which to extract the service instance.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *crnServiceInstanceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := parseCRN(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse CRN %q: %s", input, err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crn.ServiceInstance))
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/1234567890abcdef:1e2f3a4b-0000-1111-2222-333344445555:bucket:mybucket"
+
+func testCRNObject() types.Object {
+	return types.ObjectValueMust(crnAttributeTypes, map[string]attr.Value{
+		"version":          types.StringValue("v1"),
+		"cname":            types.StringValue("bluemix"),
+		"ctype":            types.StringValue("public"),
+		"service_name":     types.StringValue("cloud-object-storage"),
+		"region":           types.StringValue("global"),
+		"scope_type":       types.StringValue("a"),
+		"scope":            types.StringValue("1234567890abcdef"),
+		"service_instance": types.StringValue("1e2f3a4b-0000-1111-2222-333344445555"),
+		"resource_type":    types.StringValue("bucket"),
+		"resource":         types.StringValue("mybucket"),
+	})
+}
+
+func runFunction(f function.Function, ret function.Return, args ...attr.Value) *function.RunResponse {
+	result, _ := ret.NewResultData(context.Background())
+	resp := &function.RunResponse{Result: result}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp
+}
+
+func TestParseCRNFunction(t *testing.T) {
+	resp := runFunction(NewParseCRNFunction(), function.ObjectReturn{AttributeTypes: crnAttributeTypes}, types.StringValue(testCRN))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, testCRNObject(), resp.Result.Value())
+}
+
+func TestParseCRNFunctionGlobalScope(t *testing.T) {
+	resp := runFunction(NewParseCRNFunction(), function.ObjectReturn{AttributeTypes: crnAttributeTypes}, types.StringValue("crn:v1:bluemix:public:iam::global:::"))
+	assert.Nil(t, resp.Error)
+	attrs := resp.Result.Value().(types.Object).Attributes()
+	assert.Equal(t, types.StringValue(""), attrs["scope_type"])
+	assert.Equal(t, types.StringValue("global"), attrs["scope"])
+}
+
+func TestParseCRNFunctionInvalid(t *testing.T) {
+	for _, input := range []string{"", "not-a-crn", "crn:v1:bluemix:public:iam::a/b/c:::"} {
+		resp := runFunction(NewParseCRNFunction(), function.ObjectReturn{AttributeTypes: crnAttributeTypes}, types.StringValue(input))
+		assert.NotNil(t, resp.Error, input)
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument, input)
+	}
+}
+
+func TestBuildCRNFunction(t *testing.T) {
+	resp := runFunction(NewBuildCRNFunction(), function.StringReturn{}, testCRNObject())
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue(testCRN), resp.Result.Value())
+}
+
+func TestBuildCRNFunctionInvalid(t *testing.T) {
+	attrs := testCRNObject().Attributes()
+	attrs["service_name"] = types.StringNull()
+	attrs["resource"] = types.StringValue("a:b")
+	resp := runFunction(NewBuildCRNFunction(), function.StringReturn{}, types.ObjectValueMust(crnAttributeTypes, attrs))
+	assert.NotNil(t, resp.Error)
+	assert.Contains(t, resp.Error.Text, "service_name")
+	assert.Contains(t, resp.Error.Text, "resource")
+}
+
+func TestCRNServiceInstanceFunction(t *testing.T) {
+	resp := runFunction(NewCRNServiceInstanceFunction(), function.StringReturn{}, types.StringValue(testCRN))
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, types.StringValue("1e2f3a4b-0000-1111-2222-333344445555"), resp.Result.Value())
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &parseCRNFunction{}
+
+func NewParseCRNFunction() function.Function {
+	return &parseCRNFunction{}
+}
+
+type parseCRNFunction struct{}
+
+func (f *parseCRNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_crn"
+}
+
+func (f *parseCRNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a Cloud Resource Name (CRN) into its segments",
+		Description: "Parses an IBM Cloud Resource Name (CRN) and returns an object with its segments. " + crnAttributesDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "crn",
+				Description: "The CRN to parse, for example `crn:v1:bluemix:public:cloud-object-storage:global:a/<account_id>:<instance_id>::`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: crnAttributeTypes,
+		},
+	}
+}
+
+func (f *parseCRNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	crn, err := parseCRN(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse CRN %q: %s", input, err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, crnModelFromCRN(crn)))
+}
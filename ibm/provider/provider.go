@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -233,6 +234,152 @@ func Provider() *schema.Provider {
 				Description:  "The IBM Cloud account ID",
 				RequiredWith: []string{"iam_profile_name"},
 			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether Terraform will be prevented from destroying the resources that do not set deletion_protection",
+			},
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file that the HTTP requests and responses of the API calls are traced to, with the secrets redacted",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM file of the certificate authorities that are trusted by the API calls, in addition to the system ones",
+			},
+			"client_certificate_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key_file"},
+				Description:  "The PEM file of the client certificate of the API calls, for mutual TLS",
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_certificate_file"},
+				Description:  "The PEM file of the private key of the client certificate",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of the proxy of the API calls, instead of the proxy of the HTTPS_PROXY environment variable",
+			},
+			"keep_alive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the connections of the API calls are kept alive and reused. Defaults to true",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The tags merged into the tags of every taggable resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The default tags of the resources",
+						},
+					},
+				},
+			},
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The tags that are managed outside of Terraform, which are neither read into state nor removed from the resources",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The keys of the ignored tags",
+						},
+						"key_prefixes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The key prefixes of the ignored tags",
+						},
+					},
+				},
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The retry policy of the API calls",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of attempts of an API call, including the first one. Defaults to max_retries + 1",
+						},
+						"max_backoff": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum wait in seconds between two attempts of an API call. Defaults to 30",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The status codes of the responses that are retried. Defaults to 408, 429 and 5xx except 501",
+						},
+						"honor_retry_after": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to wait for the duration of the Retry-After header of the responses. Defaults to true",
+						},
+					},
+				},
+			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The client-side rate limits of the API calls by service",
+				Elem: &schema.Resource{
+					Schema: rateLimitsSchema(),
+				},
+			},
+			"assume_trusted_profile": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The trusted profiles that are assumed in order, the first one with the credential of the provider and the next ones with the token of the previous profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profile_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the trusted profile",
+						},
+						"profile_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the trusted profile, which requires account_id",
+						},
+						"profile_crn": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The CRN of the trusted profile",
+						},
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the account of the trusted profile",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -1898,7 +2045,7 @@ func Provider() *schema.Provider {
 			"ibm_notification_distribution_list_destination": platformnotifications.ResourceIbmNotificationDistributionListDestination(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
 
 	wrappedProvider := wrapProvider(provider)
@@ -1910,7 +2057,7 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	wrappedDataSourcesMap := map[string]*schema.Resource{}
 
 	for key, value := range provider.ResourcesMap {
-		wrappedResourcesMap[key] = wrapResource(key, value)
+		wrappedResourcesMap[key] = wrapResource(key, flex.WithDeletionProtection(flex.WithDefaultTags(vpc.WithResourceIdentity(key, value))))
 	}
 
 	for key, value := range provider.DataSourcesMap {
@@ -1918,10 +2065,10 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	}
 
 	return schema.Provider{
-		Schema:         provider.Schema,
-		DataSourcesMap: wrappedDataSourcesMap,
-		ResourcesMap:   wrappedResourcesMap,
-		ConfigureFunc:  provider.ConfigureFunc,
+		Schema:               provider.Schema,
+		DataSourcesMap:       wrappedDataSourcesMap,
+		ResourcesMap:         wrappedResourcesMap,
+		ConfigureContextFunc: provider.ConfigureContextFunc,
 	}
 }
 
@@ -1942,6 +2089,7 @@ func wrapResource(name string, resource *schema.Resource) *schema.Resource {
 		DeleteWithoutTimeout: wrapFunction(name, "delete", resource.DeleteWithoutTimeout, nil, false),
 		CustomizeDiff:        wrapCustomizeDiff(name, resource.CustomizeDiff),
 		Importer:             resource.Importer,
+		Identity:             resource.Identity,
 		DeprecationMessage:   resource.DeprecationMessage,
 		Timeouts:             resource.Timeouts,
 		Description:          resource.Description,
@@ -1972,37 +2120,51 @@ func wrapFunction(
 	fallback func(*schema.ResourceData, interface{}) error,
 	isDataSource bool,
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
-	if function != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
-			// only allow deletion if the resource is not marked as protected
-			if operationName == "delete" && schema.Get("deletion_protection") != nil {
-				// we check the value in state, not current config. Current config will always be null for a delete
-
-				if schema.Get("deletion_protection") == true {
-					log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
-					var diags diag.Diagnostics
-					summary := fmt.Sprintf("Deletion protection is enabled for resource %s to prevent accidential deletion", schema.Get("name"))
-					return append(
-						diags,
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  summary,
-							Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
-						},
-					)
-				}
+	if function == nil && fallback != nil {
+		function = func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+		}
+	}
+	if function == nil {
+		return nil
+	}
+
+	return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+		// only allow deletion if the resource is not marked as protected
+		// we check the value in state, not current config. Current config will always be null for a delete
+		if operationName == "delete" && flex.DeletionProtected(schema, meta) {
+			log.Printf("[DEBUG] Resource has deletion protection turned on %s", resourceName)
+			name := schema.Get("name")
+			if name == nil || name == "" {
+				name = schema.Id()
 			}
+			var diags diag.Diagnostics
+			summary := fmt.Sprintf("Deletion protection is enabled for resource %v to prevent accidential deletion", name)
+			return append(
+				diags,
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  summary,
+					Detail:   "Set deletion_protection to false, apply and then destroy if deletion should proceed",
+				},
+			)
+		}
 
-			return function(context, schema, meta)
+		// a change of deletion_protection alone is only stored in state
+		if operationName == "update" && !schema.HasChangeExcept(flex.DeletionProtection) {
+			return nil
 		}
-	} else if fallback != nil {
-		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
-			return wrapError(fallback(schema, meta), resourceName, operationName, isDataSource)
+
+		// the API calls of the operation are traced with the resource and the operation
+		if isDataSource {
+			context = conns.WithTraceOperation(context, fmt.Sprintf("(Data) %s", resourceName), operationName)
+		} else {
+			context = conns.WithTraceOperation(context, resourceName, operationName)
 		}
+		// the errors name the instance of the resource that failed
+		return flex.AddResourceID(function(context, schema, meta), schema.Id())
 	}
-
-	return nil
 }
 
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
@@ -2032,7 +2194,7 @@ func wrapError(err error, resourceName, operationName string, isDataSource bool)
 		diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  tfError.Error(),
-			Detail:   tfError.GetConsoleMessage(),
+			Detail:   tfError.GetDetail(),
 		},
 	)
 }
@@ -2524,7 +2686,44 @@ func Validator() validate.ValidatorDict {
 	return globalValidatorDict
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+// rateLimitsSchema returns the schema of the rate limit of every rate limited service
+func rateLimitsSchema() map[string]*schema.Schema {
+	rateLimits := map[string]*schema.Schema{}
+	for service, name := range conns.RateLimitServiceNames {
+		rateLimit := conns.DefaultRateLimits[service]
+		rateLimits[service] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("The rate limit of the API calls of %s. Defaults to %g requests per second with a burst of %d", name, rateLimit.RequestsPerSecond, rateLimit.Burst),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"requests_per_second": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						ValidateFunc: validation.FloatAtLeast(0),
+						Description:  "The number of API calls per second, or 0 for no limit",
+					},
+					"burst": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The number of API calls that are made at once before they are limited to requests_per_second",
+					},
+					"max_concurrent_requests": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The maximum number of API calls in flight, or 0 for no limit. Defaults to 0",
+					},
+				},
+			},
+		}
+	}
+	return rateLimits
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var bluemixAPIKey string
 	var bluemixTimeout int
 	var iamToken, iamRefreshToken, iamTrustedProfileId, iamTrustedProfileName, account string
@@ -2761,6 +2960,115 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	var deletionProtection bool
+	if v, ok := d.GetOkExists("deletion_protection"); ok {
+		deletionProtection = v.(bool)
+	} else if dp := os.Getenv("IC_DELETION_PROTECTION"); dp != "" {
+		deletionProtection, _ = strconv.ParseBool(dp)
+	} else if dp := os.Getenv("IBMCLOUD_DELETION_PROTECTION"); dp != "" {
+		deletionProtection, _ = strconv.ParseBool(dp)
+	}
+
+	// http_trace_file - check environment variables
+	var httpTraceFile string
+	if v, ok := d.GetOk("http_trace_file"); ok {
+		httpTraceFile = v.(string)
+	} else if f := os.Getenv("IC_HTTP_TRACE_FILE"); f != "" {
+		httpTraceFile = f
+	} else if f := os.Getenv("IBMCLOUD_HTTP_TRACE_FILE"); f != "" {
+		httpTraceFile = f
+	}
+
+	// ca_bundle_file, client_certificate_file, client_key_file - check environment variables
+	caBundleFile := d.Get("ca_bundle_file").(string)
+	if caBundleFile == "" {
+		if f := os.Getenv("IC_CA_BUNDLE_FILE"); f != "" {
+			caBundleFile = f
+		} else if f := os.Getenv("IBMCLOUD_CA_BUNDLE_FILE"); f != "" {
+			caBundleFile = f
+		}
+	}
+	clientCertificateFile := d.Get("client_certificate_file").(string)
+	clientKeyFile := d.Get("client_key_file").(string)
+	if clientCertificateFile == "" {
+		if f := os.Getenv("IC_CLIENT_CERTIFICATE_FILE"); f != "" {
+			clientCertificateFile = f
+		} else if f := os.Getenv("IBMCLOUD_CLIENT_CERTIFICATE_FILE"); f != "" {
+			clientCertificateFile = f
+		}
+	}
+	if clientKeyFile == "" {
+		if f := os.Getenv("IC_CLIENT_KEY_FILE"); f != "" {
+			clientKeyFile = f
+		} else if f := os.Getenv("IBMCLOUD_CLIENT_KEY_FILE"); f != "" {
+			clientKeyFile = f
+		}
+	}
+	keepAlive := true
+	if v, ok := d.GetOkExists("keep_alive"); ok {
+		keepAlive = v.(bool)
+	}
+
+	var defaultTags []string
+	if v, ok := d.GetOk("default_tags.0.tags"); ok {
+		defaultTags = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	var ignoreTags conns.IgnoreTags
+	if v, ok := d.GetOk("ignore_tags.0.keys"); ok {
+		ignoreTags.Keys = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("ignore_tags.0.key_prefixes"); ok {
+		ignoreTags.KeyPrefixes = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+
+	retry := conns.RetryPolicy{HonorRetryAfter: true}
+	if v, ok := d.GetOk("retry.0.max_attempts"); ok {
+		retry.MaxAttempts = v.(int)
+	}
+	if v, ok := d.GetOk("retry.0.max_backoff"); ok {
+		retry.MaxBackoff = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := d.GetOk("retry.0.retryable_status_codes"); ok {
+		for _, code := range v.(*schema.Set).List() {
+			retry.RetryableStatusCodes = append(retry.RetryableStatusCodes, code.(int))
+		}
+	}
+	if v, ok := d.GetOkExists("retry.0.honor_retry_after"); ok {
+		retry.HonorRetryAfter = v.(bool)
+	}
+
+	rateLimits := map[string]conns.RateLimit{}
+	for service := range conns.RateLimitServiceNames {
+		if _, ok := d.GetOk(fmt.Sprintf("rate_limits.0.%s", service)); !ok {
+			continue
+		}
+		// the settings that are not configured keep their defaults
+		rateLimit := conns.DefaultRateLimits[service]
+		if v, ok := d.GetOkExists(fmt.Sprintf("rate_limits.0.%s.0.requests_per_second", service)); ok {
+			rateLimit.RequestsPerSecond = v.(float64)
+		}
+		if v, ok := d.GetOkExists(fmt.Sprintf("rate_limits.0.%s.0.burst", service)); ok {
+			rateLimit.Burst = v.(int)
+		}
+		if v, ok := d.GetOkExists(fmt.Sprintf("rate_limits.0.%s.0.max_concurrent_requests", service)); ok {
+			rateLimit.MaxConcurrentRequests = v.(int)
+		}
+		rateLimits[service] = rateLimit
+	}
+
+	var assumeTrustedProfiles []conns.AssumeTrustedProfile
+	for _, v := range d.Get("assume_trusted_profile").([]interface{}) {
+		// an empty block is kept, so that it fails the validation of the profiles
+		var assumeTrustedProfile conns.AssumeTrustedProfile
+		if profile, ok := v.(map[string]interface{}); ok {
+			assumeTrustedProfile.ProfileID = profile["profile_id"].(string)
+			assumeTrustedProfile.ProfileName = profile["profile_name"].(string)
+			assumeTrustedProfile.ProfileCRN = profile["profile_crn"].(string)
+			assumeTrustedProfile.AccountID = profile["account_id"].(string)
+		}
+		assumeTrustedProfiles = append(assumeTrustedProfiles, assumeTrustedProfile)
+	}
+
 	resourceGrp := d.Get("resource_group").(string)
 	region := d.Get("region").(string)
 	zone := d.Get("zone").(string)
@@ -2819,7 +3127,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 	// Set environment variable to be used in DiffSupressFunction
 	if wskEnvVal.(string) == "" {
@@ -2847,8 +3155,40 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		EndpointsFile:         file,
 		IAMTrustedProfileID:   iamTrustedProfileId,
 		IAMTrustedProfileName: iamTrustedProfileName,
+		AssumeTrustedProfiles: assumeTrustedProfiles,
 		Account:               account,
+		DeletionProtection:    deletionProtection,
+		DefaultTags:           defaultTags,
+		IgnoreTags:            ignoreTags,
+		Retry:                 retry,
+		HTTPTraceFile:         httpTraceFile,
+		CABundleFile:          caBundleFile,
+		ClientCertificateFile: clientCertificateFile,
+		ClientKeyFile:         clientKeyFile,
+		ProxyURL:              d.Get("proxy_url").(string),
+		DisableKeepAlives:     !keepAlive,
+		RateLimits:            rateLimits,
+	}
+
+	var diags diag.Diagnostics
+	for _, problem := range config.ValidateEndpointsFile() {
+		severity := diag.Error
+		if problem.Warning {
+			severity = diag.Warning
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  problem.Summary,
+			Detail:   problem.Detail,
+		})
+	}
+	if diags.HasError() {
+		return nil, diags
 	}
 
-	return config.ClientSession()
+	session, err := config.ClientSession()
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	return session, diags
 }
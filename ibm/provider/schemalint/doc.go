@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package schemalint tests that the resources and data sources of the provider are consistent:
+// the resources can be imported, the validators that the schemas reference are registered in the
+// validator dictionary and the other way around, the attributes that look like secrets are
+// sensitive, the ForceNew attributes can be configured, and the resources that attach tags with the
+// global tagging service have a tags_all attribute for the default_tags of the provider.
+//
+// The known violations are listed in the files of the testdata directory. A new violation fails the
+// tests, and so does a listed violation that was fixed, so that it is removed from its file.
+package schemalint
@@ -0,0 +1,280 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schemalint
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourcePrefix is the prefix of the data sources in the violations
+const dataSourcePrefix = "(Data) "
+
+// secretAttribute matches the names of the attributes that look like secrets
+var secretAttribute = regexp.MustCompile(`(^|_)(password|passphrase|apikey|api_key|private_key|secret_key|client_secret|consumer_secret|application_secret|token)$|^secret$`)
+
+var (
+	providerOnce sync.Once
+	ibmProvider  *schema.Provider
+
+	// invokedValidators are the validators that the schemas looked up, by "resource identifier"
+	invokedValidators map[string]bool
+)
+
+// tagsHelper matches the calls of the helpers that attach tags with the global tagging service
+var tagsHelper = regexp.MustCompile(`\b(UpdateTagsUsingCRN|UpdateGlobalTagsUsingCRN|ResourceTagsCustomizeDiff)\b`)
+
+// resourceFunc matches the functions that return a resource
+var resourceFunc = regexp.MustCompile(`(?m)^func (Resource\w+)\(\) \*schema\.Resource \{`)
+
+// registeredResource matches the resources registered in provider.go
+var registeredResource = regexp.MustCompile(`"(ibm_\w+)":\s+(\w+\.Resource\w+)\(\)`)
+
+// getProvider returns the provider, whose lookups of the validators are recorded while its schemas
+// are built
+func getProvider() *schema.Provider {
+	providerOnce.Do(func() {
+		invokedValidators = map[string]bool{}
+		validate.SetInvocationRecorder(func(dataSource bool, resourceName, identifier string, found bool) {
+			invokedValidators[validatorKey(dataSource, resourceName, identifier)] = found
+		})
+		defer validate.SetInvocationRecorder(nil)
+		ibmProvider = provider.Provider()
+	})
+	return ibmProvider
+}
+
+func validatorKey(dataSource bool, resourceName, identifier string) string {
+	if dataSource {
+		resourceName = dataSourcePrefix + resourceName
+	}
+	if identifier == "" {
+		identifier = `""`
+	}
+	return fmt.Sprintf("%s %s", resourceName, identifier)
+}
+
+// walkSchema calls the function with the path of every attribute and block of the schema
+func walkSchema(prefix string, attributes map[string]*schema.Schema, f func(path string, attribute *schema.Schema)) {
+	for name, attribute := range attributes {
+		f(prefix+name, attribute)
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			walkSchema(prefix+name+".", elem.Schema, f)
+		}
+	}
+}
+
+// walkProvider calls the function with every attribute and block of the resources and the data
+// sources, which are named with the data source prefix
+func walkProvider(f func(resourceName, path string, attribute *schema.Schema)) {
+	p := getProvider()
+	for resourceName, resource := range p.ResourcesMap {
+		walkSchema("", resource.Schema, func(path string, attribute *schema.Schema) {
+			f(resourceName, path, attribute)
+		})
+	}
+	for dataSourceName, dataSource := range p.DataSourcesMap {
+		walkSchema("", dataSource.Schema, func(path string, attribute *schema.Schema) {
+			f(dataSourcePrefix+dataSourceName, path, attribute)
+		})
+	}
+}
+
+// readKnownViolations returns the known violations of the file of the testdata directory, one per
+// line, where the empty lines and the comments are ignored
+func readKnownViolations(t *testing.T, name string) map[string]bool {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	known := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			known[line] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return known
+}
+
+// checkViolations fails the test with the violations that are not known, and with the known
+// violations that were fixed
+func checkViolations(t *testing.T, name, check string, violations []string) {
+	t.Helper()
+	known := readKnownViolations(t, name)
+	found := map[string]bool{}
+	var unknown []string
+	for _, violation := range violations {
+		found[violation] = true
+		if !known[violation] {
+			unknown = append(unknown, violation)
+		}
+	}
+	var fixed []string
+	for violation := range known {
+		if !found[violation] {
+			fixed = append(fixed, violation)
+		}
+	}
+	sort.Strings(unknown)
+	sort.Strings(fixed)
+	if len(unknown) > 0 {
+		t.Errorf("%s:\n  %s", check, strings.Join(unknown, "\n  "))
+	}
+	if len(fixed) > 0 {
+		t.Errorf("Remove the fixed violations from testdata/%s:\n  %s", name, strings.Join(fixed, "\n  "))
+	}
+}
+
+func TestResourceImporters(t *testing.T) {
+	var violations []string
+	for resourceName, resource := range getProvider().ResourcesMap {
+		if resource.Importer == nil {
+			violations = append(violations, resourceName)
+		}
+	}
+	checkViolations(t, "missing_importers.txt", "Add an Importer to the resources", violations)
+}
+
+func TestValidatorsRegistered(t *testing.T) {
+	getProvider()
+	var violations []string
+	for key, found := range invokedValidators {
+		if !found {
+			violations = append(violations, key)
+		}
+	}
+	checkViolations(t, "unregistered_validators.txt", "Register the validators that the schemas reference in provider.Validator()", violations)
+}
+
+func TestValidatorsReferenced(t *testing.T) {
+	getProvider()
+	validators := provider.Validator()
+	var violations []string
+	for _, dictionary := range []struct {
+		dataSource bool
+		validators map[string]*validate.ResourceValidator
+	}{
+		{false, validators.ResourceValidatorDictionary},
+		{true, validators.DataSourceValidatorDictionary},
+	} {
+		for resourceName, resourceValidator := range dictionary.validators {
+			for _, validateSchema := range resourceValidator.Schema {
+				key := validatorKey(dictionary.dataSource, resourceName, validateSchema.Identifier)
+				if _, ok := invokedValidators[key]; !ok {
+					violations = append(violations, key)
+				}
+			}
+		}
+	}
+	checkViolations(t, "unreferenced_validators.txt", "Remove the validators that no schema references from provider.Validator()", violations)
+}
+
+func TestValidatorNames(t *testing.T) {
+	validators := provider.Validator()
+	var violations []string
+	for resourceName, resourceValidator := range validators.ResourceValidatorDictionary {
+		if resourceValidator.ResourceName != resourceName {
+			violations = append(violations, resourceName)
+		}
+	}
+	for dataSourceName, dataSourceValidator := range validators.DataSourceValidatorDictionary {
+		if dataSourceValidator.ResourceName != dataSourceName {
+			violations = append(violations, dataSourcePrefix+dataSourceName)
+		}
+	}
+	checkViolations(t, "validator_names.txt", "Set the ResourceName of the validators to their key in provider.Validator(), or their lookups fail", violations)
+}
+
+func TestSecretsSensitive(t *testing.T) {
+	var violations []string
+	walkProvider(func(resourceName, path string, attribute *schema.Schema) {
+		name := path[strings.LastIndex(path, ".")+1:]
+		if attribute.Type == schema.TypeString && !attribute.Sensitive && secretAttribute.MatchString(name) {
+			violations = append(violations, fmt.Sprintf("%s %s", resourceName, path))
+		}
+	})
+	checkViolations(t, "unmarked_secrets.txt", "Mark the attributes that hold secrets as Sensitive", violations)
+}
+
+func TestForceNewConfigurable(t *testing.T) {
+	var violations []string
+	walkProvider(func(resourceName, path string, attribute *schema.Schema) {
+		if attribute.ForceNew && !attribute.Optional && !attribute.Required {
+			violations = append(violations, fmt.Sprintf("%s %s", resourceName, path))
+		}
+	})
+	checkViolations(t, "force_new_computed.txt", "Make the ForceNew attributes Optional or Required, or remove ForceNew", violations)
+}
+
+// taggingResources returns the resources whose source files call the helpers that attach tags with
+// the global tagging service
+func taggingResources(t *testing.T) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("..", "..", "service", "*", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	funcs := map[string]bool{}
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		source, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tagsHelper.Match(source) {
+			continue
+		}
+		pkg := filepath.Base(filepath.Dir(file))
+		for _, match := range resourceFunc.FindAllSubmatch(source, -1) {
+			funcs[pkg+"."+string(match[1])] = true
+		}
+	}
+
+	source, err := os.ReadFile(filepath.Join("..", "provider.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resourceNames []string
+	for _, match := range registeredResource.FindAllSubmatch(source, -1) {
+		if funcs[string(match[2])] {
+			resourceNames = append(resourceNames, string(match[1]))
+		}
+	}
+	return resourceNames
+}
+
+func TestDefaultTags(t *testing.T) {
+	resources := getProvider().ResourcesMap
+	var violations []string
+	for _, resourceName := range taggingResources(t) {
+		if resource, ok := resources[resourceName]; ok {
+			if _, ok := resource.Schema[flex.TagsAll]; !ok {
+				violations = append(violations, resourceName)
+			}
+		}
+	}
+	checkViolations(t, "missing_tags_all.txt", "Add an optional and computed tags set, and a crn, to the resources that attach tags with the global tagging service, so that they attach the default_tags of the provider", violations)
+}
@@ -5,14 +5,27 @@ package provider_framework
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/functions"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/codeengine"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/directlink"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,7 +34,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &frameworkProvider{}
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithListResources      = &frameworkProvider{}
 )
 
 // frameworkProvider is the provider implementation for the IBM Cloud Terraform Provider
@@ -62,6 +78,53 @@ type frameworkProviderModel struct {
 	PrivateEndpointType    types.String `tfsdk:"private_endpoint_type"`
 	EndpointsFilePath      types.String `tfsdk:"endpoints_file_path"`
 	IBMCloudAccountID      types.String `tfsdk:"ibmcloud_account_id"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	HTTPTraceFile          types.String `tfsdk:"http_trace_file"`
+	CABundleFile           types.String `tfsdk:"ca_bundle_file"`
+	ClientCertificateFile  types.String `tfsdk:"client_certificate_file"`
+	ClientKeyFile          types.String `tfsdk:"client_key_file"`
+	ProxyURL               types.String `tfsdk:"proxy_url"`
+	KeepAlive              types.Bool   `tfsdk:"keep_alive"`
+	DefaultTags            types.List   `tfsdk:"default_tags"`
+	IgnoreTags             types.List   `tfsdk:"ignore_tags"`
+	Retry                  types.List   `tfsdk:"retry"`
+	RateLimits             types.List   `tfsdk:"rate_limits"`
+	AssumeTrustedProfile   types.List   `tfsdk:"assume_trusted_profile"`
+}
+
+// frameworkDefaultTagsModel describes the default_tags block of the provider.
+type frameworkDefaultTagsModel struct {
+	Tags types.Set `tfsdk:"tags"`
+}
+
+// frameworkIgnoreTagsModel describes the ignore_tags block of the provider.
+type frameworkIgnoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+// frameworkAssumeTrustedProfileModel describes an assume_trusted_profile block of the provider.
+type frameworkAssumeTrustedProfileModel struct {
+	ProfileID   types.String `tfsdk:"profile_id"`
+	ProfileName types.String `tfsdk:"profile_name"`
+	ProfileCRN  types.String `tfsdk:"profile_crn"`
+	AccountID   types.String `tfsdk:"account_id"`
+}
+
+// frameworkRetryModel describes the retry block of the provider.
+type frameworkRetryModel struct {
+	MaxAttempts          types.Int64 `tfsdk:"max_attempts"`
+	MaxBackoff           types.Int64 `tfsdk:"max_backoff"`
+	RetryableStatusCodes types.Set   `tfsdk:"retryable_status_codes"`
+	HonorRetryAfter      types.Bool  `tfsdk:"honor_retry_after"`
+}
+
+// frameworkRateLimitModel describes the rate limit block of a service in the rate_limits block of
+// the provider.
+type frameworkRateLimitModel struct {
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	Burst                 types.Int64   `tfsdk:"burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -202,10 +265,150 @@ func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:    true,
 				Description: "The IBM Cloud account ID",
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether Terraform will be prevented from destroying the resources that do not set deletion_protection",
+			},
+			"http_trace_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The file that the HTTP requests and responses of the API calls are traced to, with the secrets redacted",
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM file of the certificate authorities that are trusted by the API calls, in addition to the system ones",
+			},
+			"client_certificate_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM file of the client certificate of the API calls, for mutual TLS",
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM file of the private key of the client certificate",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL of the proxy of the API calls, instead of the proxy of the HTTPS_PROXY environment variable",
+			},
+			"keep_alive": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether the connections of the API calls are kept alive and reused. Defaults to true",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "The tags merged into the tags of every taggable resource",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The default tags of the resources",
+						},
+					},
+				},
+			},
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "The tags that are managed outside of Terraform, which are neither read into state nor removed from the resources",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The keys of the ignored tags",
+						},
+						"key_prefixes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The key prefixes of the ignored tags",
+						},
+					},
+				},
+			},
+			"retry": schema.ListNestedBlock{
+				Description: "The retry policy of the API calls",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of attempts of an API call, including the first one. Defaults to max_retries + 1",
+						},
+						"max_backoff": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum wait in seconds between two attempts of an API call. Defaults to 30",
+						},
+						"retryable_status_codes": schema.SetAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "The status codes of the responses that are retried. Defaults to 408, 429 and 5xx except 501",
+						},
+						"honor_retry_after": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to wait for the duration of the Retry-After header of the responses. Defaults to true",
+						},
+					},
+				},
+			},
+			"rate_limits": schema.ListNestedBlock{
+				Description: "The client-side rate limits of the API calls by service",
+				NestedObject: schema.NestedBlockObject{
+					Blocks: rateLimitsBlocks(),
+				},
+			},
+			"assume_trusted_profile": schema.ListNestedBlock{
+				Description: "The trusted profiles that are assumed in order, the first one with the credential of the provider and the next ones with the token of the previous profile",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"profile_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The ID of the trusted profile",
+						},
+						"profile_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the trusted profile, which requires account_id",
+						},
+						"profile_crn": schema.StringAttribute{
+							Optional:    true,
+							Description: "The CRN of the trusted profile",
+						},
+						"account_id": schema.StringAttribute{
+							Optional:    true,
+							Description: "The ID of the account of the trusted profile",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// rateLimitsBlocks returns the blocks of the rate limit of every rate limited service.
+func rateLimitsBlocks() map[string]schema.Block {
+	rateLimits := map[string]schema.Block{}
+	for service, name := range conns.RateLimitServiceNames {
+		rateLimit := conns.DefaultRateLimits[service]
+		rateLimits[service] = schema.ListNestedBlock{
+			Description: fmt.Sprintf("The rate limit of the API calls of %s. Defaults to %g requests per second with a burst of %d", name, rateLimit.RequestsPerSecond, rateLimit.Burst),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Optional:    true,
+						Description: "The number of API calls per second, or 0 for no limit",
+					},
+					"burst": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The number of API calls that are made at once before they are limited to requests_per_second",
+					},
+					"max_concurrent_requests": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The maximum number of API calls in flight, or 0 for no limit. Defaults to 0",
+					},
+				},
+			},
+		}
+	}
+	return rateLimits
+}
+
 // Configure prepares the provider for data sources and resources.
 // This method applies default values that were removed from the schema for mux compatibility.
 func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -385,6 +588,53 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		}
 	}
 
+	// deletion_protection - check environment variables
+	if config.DeletionProtection.IsNull() {
+		if dp := os.Getenv("IC_DELETION_PROTECTION"); dp != "" {
+			deletionProtection, _ := strconv.ParseBool(dp)
+			config.DeletionProtection = types.BoolValue(deletionProtection)
+		} else if dp := os.Getenv("IBMCLOUD_DELETION_PROTECTION"); dp != "" {
+			deletionProtection, _ := strconv.ParseBool(dp)
+			config.DeletionProtection = types.BoolValue(deletionProtection)
+		}
+	}
+
+	// http_trace_file - check environment variables
+	if config.HTTPTraceFile.IsNull() || config.HTTPTraceFile.ValueString() == "" {
+		if f := os.Getenv("IC_HTTP_TRACE_FILE"); f != "" {
+			config.HTTPTraceFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_HTTP_TRACE_FILE"); f != "" {
+			config.HTTPTraceFile = types.StringValue(f)
+		}
+	}
+
+	// ca_bundle_file - check environment variables
+	if config.CABundleFile.IsNull() || config.CABundleFile.ValueString() == "" {
+		if f := os.Getenv("IC_CA_BUNDLE_FILE"); f != "" {
+			config.CABundleFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_CA_BUNDLE_FILE"); f != "" {
+			config.CABundleFile = types.StringValue(f)
+		}
+	}
+
+	// client_certificate_file - check environment variables
+	if config.ClientCertificateFile.IsNull() || config.ClientCertificateFile.ValueString() == "" {
+		if f := os.Getenv("IC_CLIENT_CERTIFICATE_FILE"); f != "" {
+			config.ClientCertificateFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_CLIENT_CERTIFICATE_FILE"); f != "" {
+			config.ClientCertificateFile = types.StringValue(f)
+		}
+	}
+
+	// client_key_file - check environment variables
+	if config.ClientKeyFile.IsNull() || config.ClientKeyFile.ValueString() == "" {
+		if f := os.Getenv("IC_CLIENT_KEY_FILE"); f != "" {
+			config.ClientKeyFile = types.StringValue(f)
+		} else if f := os.Getenv("IBMCLOUD_CLIENT_KEY_FILE"); f != "" {
+			config.ClientKeyFile = types.StringValue(f)
+		}
+	}
+
 	// zone - check environment variables
 	if config.Zone.IsNull() || config.Zone.ValueString() == "" {
 		if zone := os.Getenv("IC_ZONE"); zone != "" {
@@ -479,6 +729,96 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 	if !config.IBMCloudAccountID.IsNull() {
 		connConfig.Account = config.IBMCloudAccountID.ValueString()
 	}
+	if !config.DeletionProtection.IsNull() {
+		connConfig.DeletionProtection = config.DeletionProtection.ValueBool()
+	}
+	if !config.HTTPTraceFile.IsNull() {
+		connConfig.HTTPTraceFile = config.HTTPTraceFile.ValueString()
+	}
+	if !config.CABundleFile.IsNull() {
+		connConfig.CABundleFile = config.CABundleFile.ValueString()
+	}
+	if !config.ClientCertificateFile.IsNull() {
+		connConfig.ClientCertificateFile = config.ClientCertificateFile.ValueString()
+	}
+	if !config.ClientKeyFile.IsNull() {
+		connConfig.ClientKeyFile = config.ClientKeyFile.ValueString()
+	}
+	if !config.ProxyURL.IsNull() {
+		connConfig.ProxyURL = config.ProxyURL.ValueString()
+	}
+	if !config.KeepAlive.IsNull() {
+		connConfig.DisableKeepAlives = !config.KeepAlive.ValueBool()
+	}
+	var defaultTags []frameworkDefaultTagsModel
+	resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+	if len(defaultTags) > 0 && !defaultTags[0].Tags.IsNull() {
+		resp.Diagnostics.Append(defaultTags[0].Tags.ElementsAs(ctx, &connConfig.DefaultTags, false)...)
+	}
+	var ignoreTags []frameworkIgnoreTagsModel
+	resp.Diagnostics.Append(config.IgnoreTags.ElementsAs(ctx, &ignoreTags, false)...)
+	if len(ignoreTags) > 0 {
+		if !ignoreTags[0].Keys.IsNull() {
+			resp.Diagnostics.Append(ignoreTags[0].Keys.ElementsAs(ctx, &connConfig.IgnoreTags.Keys, false)...)
+		}
+		if !ignoreTags[0].KeyPrefixes.IsNull() {
+			resp.Diagnostics.Append(ignoreTags[0].KeyPrefixes.ElementsAs(ctx, &connConfig.IgnoreTags.KeyPrefixes, false)...)
+		}
+	}
+	connConfig.Retry.HonorRetryAfter = true
+	var retry []frameworkRetryModel
+	resp.Diagnostics.Append(config.Retry.ElementsAs(ctx, &retry, false)...)
+	if len(retry) > 0 {
+		connConfig.Retry.MaxAttempts = int(retry[0].MaxAttempts.ValueInt64())
+		connConfig.Retry.MaxBackoff = time.Duration(retry[0].MaxBackoff.ValueInt64()) * time.Second
+		if !retry[0].RetryableStatusCodes.IsNull() {
+			var codes []int64
+			resp.Diagnostics.Append(retry[0].RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+			for _, code := range codes {
+				connConfig.Retry.RetryableStatusCodes = append(connConfig.Retry.RetryableStatusCodes, int(code))
+			}
+		}
+		if !retry[0].HonorRetryAfter.IsNull() {
+			connConfig.Retry.HonorRetryAfter = retry[0].HonorRetryAfter.ValueBool()
+		}
+	}
+	var rateLimits []types.Object
+	resp.Diagnostics.Append(config.RateLimits.ElementsAs(ctx, &rateLimits, false)...)
+	if len(rateLimits) > 0 {
+		connConfig.RateLimits = map[string]conns.RateLimit{}
+		for service, value := range rateLimits[0].Attributes() {
+			var serviceRateLimits []frameworkRateLimitModel
+			resp.Diagnostics.Append(value.(types.List).ElementsAs(ctx, &serviceRateLimits, false)...)
+			if len(serviceRateLimits) == 0 {
+				continue
+			}
+			// the settings that are not configured keep their defaults
+			rateLimit := conns.DefaultRateLimits[service]
+			if !serviceRateLimits[0].RequestsPerSecond.IsNull() {
+				rateLimit.RequestsPerSecond = serviceRateLimits[0].RequestsPerSecond.ValueFloat64()
+			}
+			if !serviceRateLimits[0].Burst.IsNull() {
+				rateLimit.Burst = int(serviceRateLimits[0].Burst.ValueInt64())
+			}
+			if !serviceRateLimits[0].MaxConcurrentRequests.IsNull() {
+				rateLimit.MaxConcurrentRequests = int(serviceRateLimits[0].MaxConcurrentRequests.ValueInt64())
+			}
+			connConfig.RateLimits[service] = rateLimit
+		}
+	}
+	var assumeTrustedProfiles []frameworkAssumeTrustedProfileModel
+	resp.Diagnostics.Append(config.AssumeTrustedProfile.ElementsAs(ctx, &assumeTrustedProfiles, false)...)
+	for _, profile := range assumeTrustedProfiles {
+		connConfig.AssumeTrustedProfiles = append(connConfig.AssumeTrustedProfiles, conns.AssumeTrustedProfile{
+			ProfileID:   profile.ProfileID.ValueString(),
+			ProfileName: profile.ProfileName.ValueString(),
+			ProfileCRN:  profile.ProfileCRN.ValueString(),
+			AccountID:   profile.AccountID.ValueString(),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Initialize client session
 	session, err := connConfig.ClientSession()
@@ -492,9 +832,11 @@ func (p *frameworkProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the client session for resources, data sources, and actions
+	// Set the client session for resources, data sources, ephemeral resources, list resources, and actions
 	resp.DataSourceData = session
 	resp.ResourceData = session
+	resp.EphemeralResourceData = session
+	resp.ListResourceData = session
 	resp.ActionData = session
 }
 
@@ -510,10 +852,53 @@ func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iamidentity.NewIAMAuthTokenEphemeralResource,
+		secretsmanager.NewSmArbitrarySecretEphemeralResource,
+		secretsmanager.NewSmKvSecretEphemeralResource,
+		secretsmanager.NewSmUsernamePasswordSecretEphemeralResource,
+		secretsmanager.NewSmIamCredentialsSecretEphemeralResource,
+		secretsmanager.NewSmServiceCredentialsSecretEphemeralResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider.
+func (p *frameworkProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		vpc.NewIsInstanceListResource,
+		vpc.NewIsVPCListResource,
+		vpc.NewIsSubnetListResource,
+		vpc.NewIsSecurityGroupListResource,
+		vpc.NewIsVolumeListResource,
+		vpc.NewIsLBListResource,
+		vpc.NewIsFloatingIPListResource,
+	}
+}
+
+// Functions defines the provider-defined functions implemented in the provider.
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseCRNFunction,
+		functions.NewBuildCRNFunction,
+		functions.NewCRNServiceInstanceFunction,
+	}
+}
+
 // Actions defines the actions implemented in the provider.
 func (p *frameworkProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		codeengine.NewCodeEngineBuildRunAction,
 		kubernetes.NewContainerVpcBareMetalWorkerReloadAction,
+		vpc.NewIsInstanceAction,
+		vpc.NewIsBareMetalServerAction,
+		power.NewPIInstanceAction,
+		power.NewPICaptureAction,
+		transitgateway.NewTransitGatewayConnectionAction,
+		directlink.NewDLGatewayAction,
+		secretsmanager.NewSmSecretRotateAction,
+		schematics.NewSchematicsWorkspaceJobAction,
+		schematics.NewSchematicsJobAction,
 	}
 }
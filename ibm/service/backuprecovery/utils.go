@@ -1,7 +1,6 @@
 package backuprecovery
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -114,26 +113,10 @@ func BackupRecoverManagerEnvFallBack(endpointsFile, endpointType, region, str st
 	if v := os.Getenv(str); v != "" {
 		return v
 	}
-	return fileFallBack(endpointsFile, endpointType, region, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY")
+	return conns.FileFallBack(endpointsFile, endpointType, "IBMCLOUD_BACKUP_RECOVERY_MANAGER_API_KEY", region, "")
 
 }
 
-func fileFallBack(f, visibility, region, key string) string {
-	fileMap := map[string]interface{}{}
-	err := json.Unmarshal([]byte(f), &fileMap)
-	if err != nil {
-		return ""
-	}
-	if val, ok := fileMap[key]; ok {
-		if v, ok := val.(map[string]interface{})[visibility]; ok {
-			if r, ok := v.(map[string]interface{})[region]; ok && r.(string) != "" {
-				return r.(string)
-			}
-		}
-	}
-	return ""
-}
-
 // Clone the base backup recovery client and set the API endpoint per the instance
 func setManagerClientAuth(originalClient *backuprecoveryv1.BackupRecoveryManagementSreApiV1, bmxsession *session.Session, region, endpointType string) (*backuprecoveryv1.BackupRecoveryManagementSreApiV1, error) {
 	// build the api endpoint
@@ -200,8 +200,12 @@ func resourceIBMCmValidationCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	mk := fmt.Sprintf("%s.%s", *version.CatalogID, *version.OfferingID)
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(context, mk); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_validation", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	valid := "valid"
 	if version.Validation.State == &valid && d.Get("revalidate_if_validated") != true {
@@ -1841,8 +1841,12 @@ func resourceIBMCmVersionCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(context, mk); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_version", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
 	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
@@ -2458,8 +2462,12 @@ func resourceIBMCmVersionUpdate(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(context, mk); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_version", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
 	getVersionOptions.SetVersionLocID(strings.Replace(d.Id(), "/", ".", 1))
@@ -2708,8 +2716,12 @@ func resourceIBMCmVersionDelete(context context.Context, d *schema.ResourceData,
 	}
 
 	mk := fmt.Sprintf("%s.%s", d.Get("catalog_id").(string), d.Get("offering_id").(string))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(context, mk); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_cm_version", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	deleteVersionOptions := &catalogmanagementv1.DeleteVersionOptions{}
 
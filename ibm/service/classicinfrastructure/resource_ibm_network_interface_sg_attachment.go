@@ -53,8 +53,10 @@ func ResourceIBMNetworkInterfaceSGAttachment() *schema.Resource {
 
 func resourceIBMNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockTimeout(mk, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
@@ -132,8 +134,10 @@ func resourceIBMNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta in
 
 func resourceIBMNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
 	mk := "network_interface_sg_attachment_" + strconv.Itoa(d.Get("network_interface_id").(int))
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockTimeout(mk, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 	sess := meta.(conns.ClientSession).SoftLayerSession()
 	service := services.GetNetworkSecurityGroupService(sess)
 	sgID, interfaceID, err := decomposeNetworkSGAttachmentID(d.Id())
@@ -872,8 +872,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			flex.DeletionProtection: {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
-				Description: "Whether Terraform will be prevented from destroying the instance",
+				Description: "Whether Terraform will be prevented from destroying the instance. Defaults to the deletion_protection of the provider",
 			},
 
 			flex.ResourceName: {
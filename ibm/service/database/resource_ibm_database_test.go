@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/go-openapi/strfmt"
@@ -290,3 +291,23 @@ func TestPickResourceBackend(t *testing.T) {
 		}
 	}
 }
+
+func TestDeletionProtectionProviderDefault(t *testing.T) {
+	resource := flex.WithDeletionProtection(ResourceIBMDatabaseInstance())
+
+	// an unset deletion_protection stays null, so that the deletion_protection of the provider applies
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"plan": "standard",
+	})
+	if _, ok := d.GetOkExists(flex.DeletionProtection); ok {
+		t.Fatalf("deletion_protection is set when it is not configured")
+	}
+
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"plan":                  "standard",
+		flex.DeletionProtection: false,
+	})
+	if v, ok := d.GetOkExists(flex.DeletionProtection); !ok || v.(bool) {
+		t.Fatalf("expected deletion_protection false, got %v", v)
+	}
+}
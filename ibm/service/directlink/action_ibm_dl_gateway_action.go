@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	dlGatewayApprovalPending = "pending"
+	dlGatewayApprovalDone    = "done"
+	dlGatewayDeleted         = "deleted"
+)
+
+var (
+	_ action.Action              = &dlGatewayApprovalAction{}
+	_ action.ActionWithConfigure = &dlGatewayApprovalAction{}
+)
+
+func NewDLGatewayAction() action.Action {
+	return &dlGatewayApprovalAction{}
+}
+
+type dlGatewayApprovalAction struct {
+	client *directlinkv1.DirectLinkV1
+}
+
+type dlGatewayApprovalActionModel struct {
+	Gateway        types.String `tfsdk:"gateway"`
+	Action         types.String `tfsdk:"action"`
+	Global         types.Bool   `tfsdk:"global"`
+	Metered        types.Bool   `tfsdk:"metered"`
+	ResourceGroup  types.String `tfsdk:"resource_group"`
+	ConnectionMode types.String `tfsdk:"connection_mode"`
+	Timeout        types.String `tfsdk:"timeout"`
+	NoWait         types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *dlGatewayApprovalAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_dl_gateway_action"
+}
+
+func (a *dlGatewayApprovalAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Approves or rejects a pending create, update or delete request of a Direct Link Connect gateway and waits until the gateway leaves the pending state. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			dlGatewayId: schema.StringAttribute{
+				Required:    true,
+				Description: "The Direct Link gateway identifier.",
+			},
+			dlCustomerAction: schema.StringAttribute{
+				Required:    true,
+				Description: "The customer action on the pending provider request, one of `create_gateway_approve`, `create_gateway_reject`, `delete_gateway_approve`, `delete_gateway_reject`, `update_attributes_approve` or `update_attributes_reject`.",
+				Validators: []validator.String{
+					validate.StringOneOf(
+						directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove,
+						directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayReject,
+						directlinkv1.CreateGatewayActionOptions_Action_DeleteGatewayApprove,
+						directlinkv1.CreateGatewayActionOptions_Action_DeleteGatewayReject,
+						directlinkv1.CreateGatewayActionOptions_Action_UpdateAttributesApprove,
+						directlinkv1.CreateGatewayActionOptions_Action_UpdateAttributesReject),
+				},
+			},
+			dlGlobal: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Gateways with global routing (true) can connect to networks outside their associated region. Required when action is `create_gateway_approve`.",
+			},
+			dlMetered: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Metered billing option. Required when action is `create_gateway_approve`.",
+			},
+			dlResourceGroup: schema.StringAttribute{
+				Optional:    true,
+				Description: "The resource group of the gateway. Only used when action is `create_gateway_approve`.",
+			},
+			dlConnectionMode: schema.StringAttribute{
+				Optional:    true,
+				Description: "Type of services this gateway is attached to, `direct` or `transit`. Only used when action is `create_gateway_approve`.",
+				Validators: []validator.String{
+					validate.StringOneOf("direct", "transit"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the gateway to leave the pending state, for example `60m` or `2h`. If not specified, defaults to `60m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the approval or rejection is accepted without waiting for the gateway. Default: false",
+			},
+		},
+	}
+}
+
+func (a *dlGatewayApprovalAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.DirectlinkV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Direct Link Client",
+			"An unexpected error occurred when creating the Direct Link client.\n\n"+
+				"Direct Link Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *dlGatewayApprovalAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config dlGatewayApprovalActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayId := config.Gateway.ValueString()
+	customerAction := config.Action.ValueString()
+
+	// Parse timeout duration, default to 60 minutes
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	createGatewayActionOptions := &directlinkv1.CreateGatewayActionOptions{
+		ID:     &gatewayId,
+		Action: &customerAction,
+	}
+	if customerAction == directlinkv1.CreateGatewayActionOptions_Action_CreateGatewayApprove {
+		if config.Global.IsNull() || config.Metered.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(dlGlobal),
+				"Missing Gateway Approval Argument",
+				fmt.Sprintf("%s and %s are required when action is %s", dlGlobal, dlMetered, customerAction),
+			)
+			return
+		}
+		createGatewayActionOptions.Global = config.Global.ValueBoolPointer()
+		createGatewayActionOptions.Metered = config.Metered.ValueBoolPointer()
+		if !config.ResourceGroup.IsNull() {
+			createGatewayActionOptions.ResourceGroup = &directlinkv1.ResourceGroupIdentity{ID: config.ResourceGroup.ValueStringPointer()}
+		}
+		if !config.ConnectionMode.IsNull() {
+			createGatewayActionOptions.ConnectionMode = config.ConnectionMode.ValueStringPointer()
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %s action to Direct Link gateway '%s'...", customerAction, gatewayId),
+	})
+
+	_, _, err := a.client.CreateGatewayActionWithContext(ctx, createGatewayActionOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Perform Direct Link Gateway Action",
+			fmt.Sprintf("Failed to perform %s on Direct Link gateway '%s': %s", customerAction, gatewayId, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Direct Link gateway '%s' %s action submitted (no-wait mode)", gatewayId, customerAction),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for Direct Link gateway '%s' to leave the pending state (timeout: %v)...", gatewayId, timeout),
+	})
+
+	gateway, err := waitForDirectLinkGatewayActionDone(ctx, a.client, gatewayId, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Direct Link Gateway Action Failed",
+			fmt.Sprintf("Failed waiting for Direct Link gateway '%s': %s", gatewayId, err.Error()),
+		)
+		return
+	}
+
+	if dlGateway, ok := gateway.(*directlinkv1.GetGatewayResponse); ok {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Direct Link gateway '%s' %s action completed: operational status %s", gatewayId, customerAction, *dlGateway.OperationalStatus),
+		})
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Direct Link gateway '%s' %s action completed: the gateway was deleted", gatewayId, customerAction),
+	})
+}
+
+func waitForDirectLinkGatewayActionDone(ctx context.Context, client *directlinkv1.DirectLinkV1, gatewayId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for direct link (%s) to leave the pending state.", gatewayId)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{dlGatewayApprovalPending},
+		Target:  []string{dlGatewayApprovalDone, dlGatewayDeleted},
+		Refresh: func() (interface{}, string, error) {
+			gatewayIntf, response, err := client.GetGatewayWithContext(ctx, &directlinkv1.GetGatewayOptions{
+				ID: &gatewayId,
+			})
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					// an approved delete request, or a rejected create request, deletes the gateway
+					return dlGatewayDeleted, dlGatewayDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error getting Direct Link gateway: %s", err)
+			}
+			gateway := gatewayIntf.(*directlinkv1.GetGatewayResponse)
+			switch *gateway.OperationalStatus {
+			case directlinkv1.GetGatewayResponse_OperationalStatus_CreatePending,
+				directlinkv1.GetGatewayResponse_OperationalStatus_DeletePending,
+				directlinkv1.GetGatewayResponse_OperationalStatus_Configuring:
+				return gateway, dlGatewayApprovalPending, nil
+			case directlinkv1.GetGatewayResponse_OperationalStatus_Failed:
+				return gateway, *gateway.OperationalStatus, fmt.Errorf("Direct Link gateway is in failed state")
+			}
+			return gateway, dlGatewayApprovalDone, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package directlink_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/networking-go-sdk/directlinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMDLGatewayActionInvokeCreateApprove(t *testing.T) {
+	gatewayname := fmt.Sprintf("tf-gateway-name-%d", acctest.RandIntRange(10, 100))
+	custAccID := "3f455c4c574447adbc14bda52f80e62f" // bbsdldv1 account
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDLGatewayActionInvokeConfig(gatewayname, custAccID, "create_gateway_approve"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_dl_provider_gateway.test_dl_gateway", "name", gatewayname),
+					testAccCheckIBMDLGatewayActionOperationalStatus("ibm_dl_provider_gateway.test_dl_gateway", "provisioned"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMDLGatewayActionInvokeInvalidAction(t *testing.T) {
+	gatewayname := fmt.Sprintf("tf-gateway-name-%d", acctest.RandIntRange(10, 100))
+	custAccID := "3f455c4c574447adbc14bda52f80e62f" // bbsdldv1 account
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDLGatewayActionInvokeConfig(gatewayname, custAccID, "create_gateway_accept"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMDLGatewayActionOperationalStatus checks the operational status of the gateway after the action
+func testAccCheckIBMDLGatewayActionOperationalStatus(n, operationalStatus string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).DirectlinkV1API()
+		if err != nil {
+			return err
+		}
+
+		gatewayIntf, _, err := client.GetGateway(&directlinkv1.GetGatewayOptions{
+			ID: &rs.Primary.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error getting Direct Link gateway: %s", err)
+		}
+
+		gateway := gatewayIntf.(*directlinkv1.GetGatewayResponse)
+		if *gateway.OperationalStatus != operationalStatus {
+			return fmt.Errorf("Expected Direct Link gateway operational status %s, got %s", operationalStatus, *gateway.OperationalStatus)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMDLGatewayActionInvokeConfig(gatewayname, custAccID, customerAction string) string {
+	return fmt.Sprintf(`
+data "ibm_dl_provider_ports" "test_ds_dl_ports" {
+}
+
+resource "ibm_dl_provider_gateway" "test_dl_gateway" {
+	bgp_asn             = 64999
+	bgp_ibm_cidr        = "169.254.10.29/30"
+	bgp_cer_cidr        = "169.254.10.30/30"
+	name                = "%s"
+	customer_account_id = "%s"
+	speed_mbps          = 1000
+	port                = data.ibm_dl_provider_ports.test_ds_dl_ports.ports[0].port_id
+	vlan                = 25
+}
+
+action "ibm_dl_gateway_action" "approve" {
+	provider = ibm.account2
+	config {
+		gateway = ibm_dl_provider_gateway.test_dl_gateway.id
+		action  = "%s"
+		global  = true
+		metered = false
+	}
+}
+
+resource "null_resource" "trigger" {
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.ibm_dl_gateway_action.approve]
+		}
+	}
+	depends_on = [ibm_dl_provider_gateway.test_dl_gateway]
+}
+`, gatewayname, custAccID, customerAction)
+}
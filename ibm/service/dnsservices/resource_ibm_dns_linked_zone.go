@@ -127,8 +127,10 @@ func resourceIBMDNSLinkedZoneCreate(ctx context.Context, d *schema.ResourceData,
 	createLinkedZoneOptions.SetDescription(description)
 	createLinkedZoneOptions.SetLabel(label)
 	mk := "dns_linked_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "ibm_dns_linked_zone", "create").GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	resource, response, err := sess.CreateLinkedZone(createLinkedZoneOptions)
 	if err != nil {
@@ -209,8 +211,10 @@ func resourceIBMDNSLinkedZoneUpdate(ctx context.Context, d *schema.ResourceData,
 		updateLinkedZoneOptions.SetLabel(label)
 
 		mk := "dns_linked_zone_" + instanceID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+			return flex.TerraformErrorf(err, err.Error(), "ibm_dns_linked_zone", "update").GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(mk)
 
 		_, response, err := sess.UpdateLinkedZone(updateLinkedZoneOptions)
 
@@ -239,8 +243,10 @@ func resourceIBMDNSLinkedZoneDelete(ctx context.Context, d *schema.ResourceData,
 	deleteLinkedZoneOptions := sess.NewDeleteLinkedZoneOptions(instanceID, linkedDnsZoneID)
 
 	mk := "linked_dns_zone_" + instanceID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "ibm_dns_linked_zone", "delete").GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 	response, err := sess.DeleteLinkedZone(deleteLinkedZoneOptions)
 
 	if err != nil {
@@ -112,8 +112,10 @@ func resourceIBMPrivateDNSSecondaryZoneCreate(ctx context.Context, d *schema.Res
 	createSecondaryZoneOptions.SetEnabled(enabled)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "ibm_dns_custom_resolver_secondary_zone", "create").GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	resource, response, err := sess.CreateSecondaryZone(createSecondaryZoneOptions)
 	if err != nil {
@@ -209,8 +211,10 @@ func resourceIBMPrivateDNSSecondaryZoneUpdate(ctx context.Context, d *schema.Res
 		updateSecondaryZoneOptions.SetEnabled(enabled)
 
 		mk := "private_dns_secondary_zone_" + instanceID + resolverID
-		conns.IbmMutexKV.Lock(mk)
-		defer conns.IbmMutexKV.Unlock(mk)
+		if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+			return flex.TerraformErrorf(err, err.Error(), "ibm_dns_custom_resolver_secondary_zone", "update").GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(mk)
 
 		_, response, err := sess.UpdateSecondaryZone(updateSecondaryZoneOptions)
 
@@ -241,8 +245,10 @@ func resourceIBMPrivateDNSSecondaryZoneDelete(ctx context.Context, d *schema.Res
 	deleteSecondaryZoneOptions := sess.NewDeleteSecondaryZoneOptions(instanceID, resolverID, secondaryZoneID)
 
 	mk := "private_dns_secondary_zone_" + instanceID + resolverID
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockContext(ctx, mk); err != nil {
+		return flex.TerraformErrorf(err, err.Error(), "ibm_dns_custom_resolver_secondary_zone", "delete").GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 	response, err := sess.DeleteSecondaryZone(deleteSecondaryZoneOptions)
 
 	if err != nil {
@@ -301,8 +301,10 @@ func resourceIBMPrivateDNSResourceRecordCreate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + instanceID + zoneID + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockTimeout(mk, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 	response, detail, err := sess.CreateResourceRecord(createResourceRecordOptions)
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error creating dns services resource record:%s\n%s", err, detail)
@@ -392,8 +394,10 @@ func resourceIBMPrivateDNSResourceRecordUpdate(d *schema.ResourceData, meta inte
 	rand.Seed(time.Now().UnixNano())
 	randI := fmt.Sprint(rand.Intn(50))
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockTimeout(mk, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 
 	updateResourceRecordOptions := sess.NewUpdateResourceRecordOptions(idSet[0], idSet[1], idSet[2], "", nil)
 
@@ -501,8 +505,10 @@ func resourceIBMPrivateDNSResourceRecordDelete(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	deleteResourceRecordOptions := sess.NewDeleteResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.LockTimeout(mk, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(mk)
 	response, err := sess.DeleteResourceRecord(deleteResourceRecordOptions)
 	if err != nil {
 		return flex.FmtErrorf("[ERROR] Error deleting dns services resource record:%s\n%s", err, response)
@@ -526,8 +532,10 @@ func resourceIBMPrivateDNSResourceRecordExists(d *schema.ResourceData, meta inte
 	randI := fmt.Sprint(rand.Intn(50))
 	getResourceRecordOptions := sess.NewGetResourceRecordOptions(idSet[0], idSet[1], idSet[2])
 	mk := "private_dns_resource_record_" + idSet[0] + idSet[1] + randI
-	conns.IbmMutexKV.Lock(mk)
-	defer conns.IbmMutexKV.Unlock(mk)
+	if err := conns.IbmLockManager.RLockTimeout(mk, d.Timeout(schema.TimeoutRead)); err != nil {
+		return false, err
+	}
+	defer conns.IbmLockManager.RUnlock(mk)
 	_, response, err := sess.GetResourceRecord(getResourceRecordOptions)
 
 	if err != nil {
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &iamAuthTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &iamAuthTokenEphemeralResource{}
+)
+
+func NewIAMAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &iamAuthTokenEphemeralResource{}
+}
+
+type iamAuthTokenEphemeralResource struct {
+	session conns.ClientSession
+}
+
+type iamAuthTokenModel struct {
+	IAMAccessToken  types.String `tfsdk:"iam_access_token"`
+	IAMRefreshToken types.String `tfsdk:"iam_refresh_token"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+func (e *iamAuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_iam_auth_token"
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the IAM access and refresh tokens of the provider session. The tokens are never persisted to the Terraform plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"iam_access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM access token, including the `Bearer ` prefix.",
+			},
+			"iam_refresh_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IAM refresh token. Empty when the provider is configured with an access token only.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration time of the IAM access token in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.session = session
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data iamAuthTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bmxSess, err := e.session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Get IBM Cloud Session",
+			"An unexpected error occurred when retrieving the IBM Cloud session.\n\n"+
+				"IBM Cloud Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the token of the session from the authenticator, which returns its cached token until it
+	// is about to expire. The token is valid until the returned expires_at.
+	if err := conns.RefreshToken(bmxSess); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh IAM Token",
+			"An unexpected error occurred when refreshing the IAM access token.\n\n"+
+				"IAM Error: "+err.Error(),
+		)
+		return
+	}
+
+	accessToken := bmxSess.Config.IAMAccessToken
+	data.IAMAccessToken = types.StringValue(accessToken)
+	data.IAMRefreshToken = types.StringValue(bmxSess.Config.IAMRefreshToken)
+
+	expiresAt, err := iamAccessTokenExpiry(accessToken)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Determine IAM Token Expiry",
+			"The IAM access token could not be parsed to determine its expiration time: "+err.Error(),
+		)
+		data.ExpiresAt = types.StringNull()
+	} else {
+		data.ExpiresAt = types.StringValue(expiresAt.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// iamAccessTokenExpiry returns the "exp" claim of an IAM access token. The token signature is
+// not verified, the token was issued to the provider by IAM.
+func iamAccessTokenExpiry(accessToken string) (time.Time, error) {
+	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(accessToken, claims); err != nil {
+		return time.Time{}, err
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if exp == nil {
+		return time.Time{}, fmt.Errorf("token has no expiration claim")
+	}
+	return exp.Time, nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAuthTokenEphemeralResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAuthTokenEphemeralResourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIAMAuthTokenNotInState(),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMIAMAuthTokenNotInState verifies that neither the ephemeral resource nor its token
+// made it into the state.
+func testAccCheckIBMIAMAuthTokenNotInState() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			if strings.Contains(name, "ibm_iam_auth_token") {
+				return fmt.Errorf("ephemeral resource %s was persisted to state", name)
+			}
+			for key, value := range rs.Primary.Attributes {
+				if strings.HasPrefix(value, "Bearer ") {
+					return fmt.Errorf("IAM access token found in state attribute %s.%s", name, key)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAuthTokenEphemeralResourceConfig() string {
+	return `
+	ephemeral "ibm_iam_auth_token" "token" {}
+
+	data "ibm_iam_users" "users" {}
+	`
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func testIAMAccessToken(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("key1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestIAMAccessTokenExpiry(t *testing.T) {
+	expiration := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expiresAt, err := iamAccessTokenExpiry(testIAMAccessToken(t, jwt.MapClaims{"iam_id": "IBMid-1", "exp": expiration.Unix()}))
+	assert.Nil(t, err)
+	assert.True(t, expiration.Equal(expiresAt))
+
+	// the token of the session has the Bearer prefix
+	expiresAt, err = iamAccessTokenExpiry("Bearer " + testIAMAccessToken(t, jwt.MapClaims{"exp": expiration.Unix()}))
+	assert.Nil(t, err)
+	assert.True(t, expiration.Equal(expiresAt))
+}
+
+func TestIAMAccessTokenExpiryMissing(t *testing.T) {
+	_, err := iamAccessTokenExpiry(testIAMAccessToken(t, jwt.MapClaims{"iam_id": "IBMid-1"}))
+	assert.EqualError(t, err, "token has no expiration claim")
+}
+
+func TestIAMAccessTokenExpiryMalformed(t *testing.T) {
+	for _, token := range []string{"", "token1", "header.payload.signature"} {
+		_, err := iamAccessTokenExpiry(token)
+		assert.NotNil(t, err, token)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -72,7 +73,7 @@ func (a *containerVpcBareMetalWorkerReloadAction) Schema(ctx context.Context, re
 				Optional:    true,
 				Description: "Maximum time to wait for the bare metal worker reload to complete, for example `30m` or `1h`. If not specified, defaults to `45m`. Ignored when no_wait is true.",
 				Validators: []validator.String{
-					ValidDuration(),
+					validate.ValidDuration(),
 				},
 			},
 			"no_wait": schema.BoolAttribute{
@@ -219,35 +220,3 @@ func bareMetalWorkerReloadRefreshFunc(vpcWorkerClient containerv2.Workers, bareM
 		return worker, worker.LifeCycle.ActualState, nil
 	}
 }
-
-// Duration Validator
-var _ validator.String = durationValidator{}
-
-type durationValidator struct{}
-
-func (v durationValidator) Description(ctx context.Context) string {
-	return "string must be a valid duration format (e.g., '30m', '1h')"
-}
-
-func (v durationValidator) MarkdownDescription(ctx context.Context) string {
-	return "string must be a valid duration format (e.g., `30m`, `1h`)"
-}
-
-func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-	if req.ConfigValue.IsNull() {
-		return
-	}
-
-	val := req.ConfigValue.ValueString()
-	if _, err := time.ParseDuration(val); err != nil {
-		resp.Diagnostics.AddAttributeError(
-			req.Path,
-			"Invalid Timeout Format",
-			fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '30m' or '1h'.", val, err.Error()),
-		)
-	}
-}
-
-func ValidDuration() validator.String {
-	return durationValidator{}
-}
@@ -159,8 +159,10 @@ func dataSourceIBMContainerClusterConfigRead(d *schema.ResourceData, meta interf
 	endpointType := d.Get("endpoint_type").(string)
 
 	clusterId := "Cluster_Config_" + name
-	conns.IbmMutexKV.Lock(clusterId)
-	defer conns.IbmMutexKV.Unlock(clusterId)
+	if err := conns.IbmLockManager.LockTimeout(clusterId, d.Timeout(schema.TimeoutRead)); err != nil {
+		return err
+	}
+	defer conns.IbmLockManager.Unlock(clusterId)
 
 	if len(configDir) == 0 {
 		configDir, err = homedir.Dir()
@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &piCaptureAction{}
+	_ action.ActionWithConfigure = &piCaptureAction{}
+)
+
+func NewPICaptureAction() action.Action {
+	return &piCaptureAction{}
+}
+
+type piCaptureAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type piCaptureActionModel struct {
+	CaptureCloudStorageAccessKey types.String `tfsdk:"pi_capture_cloud_storage_access_key"`
+	CaptureCloudStorageRegion    types.String `tfsdk:"pi_capture_cloud_storage_region"`
+	CaptureCloudStorageSecretKey types.String `tfsdk:"pi_capture_cloud_storage_secret_key"`
+	CaptureDestination           types.String `tfsdk:"pi_capture_destination"`
+	CaptureName                  types.String `tfsdk:"pi_capture_name"`
+	CaptureStorageImagePath      types.String `tfsdk:"pi_capture_storage_image_path"`
+	CaptureVolumeIDs             types.List   `tfsdk:"pi_capture_volume_ids"`
+	CloudInstanceID              types.String `tfsdk:"pi_cloud_instance_id"`
+	InstanceName                 types.String `tfsdk:"pi_instance_name"`
+	UserTags                     types.List   `tfsdk:"pi_user_tags"`
+	Timeout                      types.String `tfsdk:"timeout"`
+	NoWait                       types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *piCaptureAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_capture"
+}
+
+func (a *piCaptureAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Captures a Power Virtual Server instance to a deployable image in the image catalog, exports it to Cloud Object Storage, or both, and waits for the capture job to complete. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CaptureCloudStorageAccessKey: schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Cloud Storage access key. Required when the capture destination is `cloud-storage` or `both`.",
+			},
+			Arg_CaptureCloudStorageRegion: schema.StringAttribute{
+				Optional:    true,
+				Description: "Cloud Storage region. Required when the capture destination is `cloud-storage` or `both`.",
+			},
+			Arg_CaptureCloudStorageSecretKey: schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Cloud Storage secret key. Required when the capture destination is `cloud-storage` or `both`.",
+			},
+			Arg_CaptureDestination: schema.StringAttribute{
+				Required:    true,
+				Description: "Destination for the deployable image, one of `image-catalog`, `cloud-storage` or `both`.",
+				Validators: []validator.String{
+					validate.StringOneOf(ImageCatalog, CloudStorage, Both),
+				},
+			},
+			Arg_CaptureName: schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the capture to create. Note : this must be unique",
+			},
+			Arg_CaptureStorageImagePath: schema.StringAttribute{
+				Optional:    true,
+				Description: "Cloud Storage image path (bucket-name [/folder/../..]). Required when the capture destination is `cloud-storage` or `both`.",
+			},
+			Arg_CaptureVolumeIDs: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "List of data volume IDs to include in the capture.",
+			},
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the service instance associated with an account.",
+			},
+			Arg_InstanceName: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or name of the PVM instance to capture.",
+			},
+			Arg_UserTags: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "List of user tags attached to the captured image.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the capture job to complete, for example `75m` or `2h`. If not specified, defaults to `75m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the capture job is created without waiting for completion. Default: false",
+			},
+		},
+	}
+}
+
+func (a *piCaptureAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	piSession, err := session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Power Virtual Server Session",
+			"An unexpected error occurred when creating the Power Virtual Server session.\n\n"+
+				"Power Virtual Server Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.session = piSession
+}
+
+func (a *piCaptureAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config piCaptureActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	name := config.InstanceName.ValueString()
+	captureName := config.CaptureName.ValueString()
+	captureDestination := config.CaptureDestination.ValueString()
+
+	// Parse timeout duration, default to 75 minutes
+	timeout := 75 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	captureBody := &models.PVMInstanceCapture{
+		CaptureDestination: &captureDestination,
+		CaptureName:        &captureName,
+	}
+	if captureDestination != ImageCatalog {
+		for _, required := range []struct {
+			attribute string
+			value     types.String
+		}{
+			{Arg_CaptureCloudStorageRegion, config.CaptureCloudStorageRegion},
+			{Arg_CaptureCloudStorageAccessKey, config.CaptureCloudStorageAccessKey},
+			{Arg_CaptureStorageImagePath, config.CaptureStorageImagePath},
+			{Arg_CaptureCloudStorageSecretKey, config.CaptureCloudStorageSecretKey},
+		} {
+			if required.value.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(required.attribute),
+					"Missing Cloud Storage Argument",
+					fmt.Sprintf("%s is required when capture destination is %s", required.attribute, captureDestination),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		captureBody.CloudStorageAccessKey = config.CaptureCloudStorageAccessKey.ValueString()
+		captureBody.CloudStorageRegion = config.CaptureCloudStorageRegion.ValueString()
+		captureBody.CloudStorageSecretKey = config.CaptureCloudStorageSecretKey.ValueString()
+		captureBody.CloudStorageImagePath = config.CaptureStorageImagePath.ValueString()
+	}
+	if !config.CaptureVolumeIDs.IsNull() {
+		var volumeIDs []string
+		resp.Diagnostics.Append(config.CaptureVolumeIDs.ElementsAs(ctx, &volumeIDs, false)...)
+		if len(volumeIDs) > 0 {
+			captureBody.CaptureVolumeIDs = volumeIDs
+		}
+	}
+	if !config.UserTags.IsNull() {
+		var userTags []string
+		resp.Diagnostics.Append(config.UserTags.ElementsAs(ctx, &userTags, false)...)
+		captureBody.UserTags = userTags
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Capturing instance '%s' as '%s' to %s...", name, captureName, captureDestination),
+	})
+
+	client := instance.NewIBMPIInstanceClient(ctx, a.session, cloudInstanceID)
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(name, captureBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Capture Power Virtual Server Instance",
+			fmt.Sprintf("Failed to capture instance '%s': %s", name, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Capture '%s' submitted as job '%s' (no-wait mode)", captureName, *captureResponse.ID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for capture job '%s' to complete (timeout: %v)...", *captureResponse.ID, timeout),
+	})
+
+	jobClient := instance.NewIBMPIJobClient(ctx, a.session, cloudInstanceID)
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Power Virtual Server Capture Failed",
+			fmt.Sprintf("Failed waiting for capture job '%s' of instance '%s': %s", *captureResponse.ID, name, err.Error()),
+		)
+		return
+	}
+
+	if captureDestination != CloudStorage {
+		imageClient := instance.NewIBMPIImageClient(ctx, a.session, cloudInstanceID)
+		image, err := imageClient.Get(captureName)
+		if err == nil && image.ImageID != nil {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Captured image '%s' has ID '%s'", captureName, *image.ImageID),
+			})
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Capture '%s' of instance '%s' completed successfully", captureName, name),
+	})
+}
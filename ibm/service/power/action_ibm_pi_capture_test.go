@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPICaptureActionInvokeImageCatalog(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-capture-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPICaptureActionInvokeConfig(name, power.ImageCatalog),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPICaptureActionImageExists(name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPICaptureActionInvokeMissingCloudStorage(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-capture-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPICaptureActionInvokeConfig(name, power.CloudStorage),
+				ExpectError: regexp.MustCompile(`Missing Cloud Storage Argument`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMPICaptureActionImageExists checks the image of the capture in the image catalog
+func testAccCheckIBMPICaptureActionImageExists(captureName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIImageClient(context.Background(), sess, acc.Pi_cloud_instance_id)
+		_, err = client.Get(captureName)
+		return err
+	}
+}
+
+func testAccCheckIBMPICaptureActionInvokeConfig(name, destination string) string {
+	return testAccCheckIBMPIInstanceConfig(name, power.OK) + fmt.Sprintf(`
+	action "ibm_pi_capture" "capture_instance" {
+		config {
+			pi_cloud_instance_id   = "%[1]s"
+			pi_capture_name        = "%[2]s"
+			pi_instance_name       = ibm_pi_instance.power_instance.pi_instance_name
+			pi_capture_destination = "%[3]s"
+		}
+	}
+
+	resource "null_resource" "trigger_capture" {
+		depends_on = [ibm_pi_instance.power_instance]
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_pi_capture.capture_instance]
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, destination)
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &piInstanceOperationAction{}
+	_ action.ActionWithConfigure = &piInstanceOperationAction{}
+)
+
+func NewPIInstanceAction() action.Action {
+	return &piInstanceOperationAction{}
+}
+
+type piInstanceOperationAction struct {
+	session *ibmpisession.IBMPISession
+}
+
+type piInstanceOperationActionModel struct {
+	Action          types.String `tfsdk:"pi_action"`
+	CloudInstanceID types.String `tfsdk:"pi_cloud_instance_id"`
+	HealthStatus    types.String `tfsdk:"pi_health_status"`
+	InstanceID      types.String `tfsdk:"pi_instance_id"`
+	Timeout         types.String `tfsdk:"timeout"`
+	NoWait          types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *piInstanceOperationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_instance_action"
+}
+
+func (a *piInstanceOperationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Performs an operation on a Power Virtual Server instance, such as a start, stop, reboot or reset of its state, and waits for the instance to reach the target status. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			Arg_Action: schema.StringAttribute{
+				Required:    true,
+				Description: "PVM instance action type, one of `dumprestart`, `hard-reboot`, `immediate-shutdown`, `reset-state`, `soft-reboot`, `start` or `stop`.",
+				Validators: []validator.String{
+					validate.StringOneOf(Action_Dumprestart, Action_HardReboot, Action_ImmediateShutdown,
+						Action_ResetState, Action_SoftReboot, Action_Start, Action_Stop),
+				},
+			},
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the service instance associated with an account.",
+			},
+			Arg_HealthStatus: schema.StringAttribute{
+				Optional:    true,
+				Description: "The health status of the PVM instance to wait for, `OK` or `WARNING`. If not specified, defaults to `OK`. Set `WARNING` to connect to the instance faster.",
+				Validators: []validator.String{
+					validate.StringOneOf(OK, Warning),
+				},
+			},
+			Arg_InstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The ID or name of the PVM instance.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the instance to reach the target status, for example `15m` or `1h`. If not specified, defaults to `15m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the operation is accepted without waiting for the target status. Default: false",
+			},
+		},
+	}
+}
+
+func (a *piInstanceOperationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	piSession, err := session.IBMPISession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Power Virtual Server Session",
+			"An unexpected error occurred when creating the Power Virtual Server session.\n\n"+
+				"Power Virtual Server Session Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.session = piSession
+}
+
+func (a *piInstanceOperationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config piInstanceOperationActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	id := config.InstanceID.ValueString()
+	operation := config.Action.ValueString()
+	targetHealthStatus := OK
+	if !config.HealthStatus.IsNull() {
+		targetHealthStatus = config.HealthStatus.ValueString()
+	}
+
+	// Parse timeout duration, default to 15 minutes
+	timeout := 15 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	var targetStatus string
+	switch operation {
+	case Action_ImmediateShutdown, Action_Stop:
+		targetStatus = State_Shutoff
+	case Action_ResetState:
+		targetStatus = State_Active
+		targetHealthStatus = Critical
+	default:
+		// action is "dumprestart", "hard-reboot", "start", or "soft-reboot"
+		targetStatus = State_Active
+	}
+
+	client := instance.NewIBMPIInstanceClient(ctx, a.session, cloudInstanceID)
+
+	// skip the operation if the instance is already in the target status
+	if operation == Action_Start || operation == Action_Stop || operation == Action_ImmediateShutdown {
+		pvm, err := client.Get(id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Get Power Virtual Server Instance",
+				fmt.Sprintf("Failed to get instance '%s': %s", id, err.Error()),
+			)
+			return
+		}
+		if strings.ToLower(*pvm.Status) == targetStatus && pvm.Health != nil && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == OK) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Instance '%s' is already %s, skipping %s", id, targetStatus, operation),
+			})
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %s action to instance '%s'...", operation, id),
+	})
+
+	err := client.Action(id, &models.PVMInstanceAction{Action: &operation})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Perform Power Virtual Server Instance Action",
+			fmt.Sprintf("Failed to %s instance '%s': %s", operation, id, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Instance '%s' %s action submitted (no-wait mode)", id, operation),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for instance '%s' to be %s with health status %s (timeout: %v)...", id, targetStatus, targetHealthStatus, timeout),
+	})
+
+	_, err = isWaitForPIInstanceActionStatus(ctx, client, id, timeout, targetStatus, targetHealthStatus)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Power Virtual Server Instance Action Failed",
+			fmt.Sprintf("Failed waiting for instance '%s' to be %s: %s", id, targetStatus, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance '%s' %s action completed successfully", id, operation),
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIInstanceActionInvokeStop(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceActionInvokeConfig(name, power.Action_Stop),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceActionInvokeStatus("ibm_pi_instance.power_instance", power.State_Shutoff),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIInstanceActionInvokeSoftReboot(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceActionInvokeConfig(name, power.Action_SoftReboot),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceActionInvokeStatus("ibm_pi_instance.power_instance", power.State_Active),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIInstanceActionInvokeInvalidAction(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMPIInstanceActionInvokeConfig(name, "restart"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMPIInstanceActionInvokeStatus checks the status of the instance after the action
+func testAccCheckIBMPIInstanceActionInvokeStatus(n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := instance.NewIBMPIInstanceClient(context.Background(), sess, parts[0])
+		pvm, err := client.Get(parts[1])
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(*pvm.Status) != status {
+			return fmt.Errorf("Instance %s is %s, expected %s", parts[1], *pvm.Status, status)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIInstanceActionInvokeConfig(name, action string) string {
+	return testAccCheckIBMPIInstanceConfig(name, power.OK) + fmt.Sprintf(`
+	action "ibm_pi_instance_action" "example" {
+		config {
+			pi_action            = "%[2]s"
+			pi_cloud_instance_id = "%[1]s"
+			pi_instance_id       = ibm_pi_instance.power_instance.instance_id
+		}
+	}
+
+	resource "null_resource" "trigger_action" {
+		depends_on = [ibm_pi_instance.power_instance]
+
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_pi_instance_action.example]
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, action)
+}
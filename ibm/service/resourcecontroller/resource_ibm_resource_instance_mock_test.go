@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	mockServiceID     = "mock-service-id"
+	mockServicePlanID = "mock-service-plan-id"
+)
+
+// mockIBMResourceInstances mocks the resource catalog and resource controller APIs called by the
+// ibm_resource_instance resource, for a "cloud-object-storage" service with a "lite" plan.
+func mockIBMResourceInstances(server *unittest.MockServer) *unittest.MockCollection {
+	server.Service("IBMCLOUD_GS_API_ENDPOINT").Respond("POST /v3/resources/search", http.StatusOK, `{"items": []}`)
+
+	deploymentCRN := fmt.Sprintf("crn:v1:bluemix:public:globalcatalog::::deployment:%s%%3A%s", mockServicePlanID, unittest.MockRegion)
+	catalog := server.Service("IBMCLOUD_RESOURCE_CATALOG_API_ENDPOINT")
+	catalog.Respond("GET /api/v1/{$}", http.StatusOK, map[string]interface{}{
+		"resources": []interface{}{
+			map[string]interface{}{
+				"id":   mockServiceID,
+				"name": "cloud-object-storage",
+				"kind": "service",
+				"metadata": map[string]interface{}{
+					"service": map[string]interface{}{
+						"rc_provisionable": true,
+						"iam_compatible":   true,
+					},
+				},
+			},
+		},
+	})
+	catalog.Respond("GET /api/v1/"+mockServiceID+"/plan", http.StatusOK, map[string]interface{}{
+		"resources": []interface{}{
+			map[string]interface{}{"id": mockServicePlanID, "name": "lite", "kind": "plan"},
+		},
+	})
+	catalog.Respond("GET /api/v1/"+mockServicePlanID+"/deployment", http.StatusOK, map[string]interface{}{
+		"resources": []interface{}{
+			map[string]interface{}{
+				"id":          mockServicePlanID + ":" + unittest.MockRegion,
+				"name":        unittest.MockRegion,
+				"catalog_crn": deploymentCRN,
+				"metadata": map[string]interface{}{
+					"rc_compatible": true,
+					"deployment": map[string]interface{}{
+						"location":   unittest.MockRegion,
+						"target_crn": deploymentCRN,
+					},
+				},
+			},
+		},
+	})
+	catalog.Respond("GET /api/v1/"+mockServiceID, http.StatusOK, map[string]interface{}{"kind": "service", "name": "cloud-object-storage"})
+	catalog.Respond("GET /api/v1/"+mockServicePlanID, http.StatusOK, map[string]interface{}{"kind": "plan", "name": "lite"})
+
+	return server.Service("IBMCLOUD_RESOURCE_CONTROLLER_API_ENDPOINT").Collection("/v2/resource_instances", &unittest.MockCollection{
+		ListKey: "resources",
+		New: func(id string, body map[string]interface{}) map[string]interface{} {
+			crn := fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:%s:a/%s:%s::", unittest.MockRegion, unittest.MockAccountID, id)
+			return map[string]interface{}{
+				"id":                id,
+				"guid":              id,
+				"crn":               crn,
+				"name":              body["name"],
+				"state":             "active",
+				"type":              "service_instance",
+				"account_id":        unittest.MockAccountID,
+				"resource_group_id": body["resource_group"],
+				"resource_id":       mockServiceID,
+				"resource_plan_id":  body["resource_plan_id"],
+				"target_crn":        body["target"],
+				"locked":            false,
+				"allow_cleanup":     false,
+				"created_at":        "2026-01-01T00:00:00Z",
+			}
+		},
+		// The resource controller keeps the deleted instances in the removed state.
+		Delete: func(item map[string]interface{}) bool {
+			item["state"] = "removed"
+			return true
+		},
+	})
+}
+
+func TestUnitIBMResourceInstanceMock(t *testing.T) {
+	server := unittest.NewMockServer(t)
+	instances := mockIBMResourceInstances(server)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { unittest.SkipWithoutTerraform(t) },
+		ProviderFactories: server.ProviderFactories(provider.Provider),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if instance, found := instances.Get(rs.Primary.ID); found && instance["state"] != "removed" {
+					return fmt.Errorf("Resource instance still exists: %s", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitIBMResourceInstanceConfig("instance1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "name", "instance1"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "service", "cloud-object-storage"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "plan", "lite"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "location", unittest.MockRegion),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "status", "active"),
+				),
+			},
+			{
+				Config: testUnitIBMResourceInstanceConfig("instance2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "name", "instance2"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitIBMResourceInstanceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "lite"
+		location          = "%s"
+		resource_group_id = "%s"
+	}`, name, unittest.MockRegion, unittest.MockResourceGroupID)
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	schematicsJobPending = "job_pending"
+	schematicsJobDone    = "job_done"
+)
+
+var (
+	_ action.Action              = &schematicsJobRunAction{}
+	_ action.ActionWithConfigure = &schematicsJobRunAction{}
+)
+
+func NewSchematicsJobAction() action.Action {
+	return &schematicsJobRunAction{}
+}
+
+type schematicsJobRunAction struct {
+	session conns.ClientSession
+}
+
+type schematicsJobRunActionModel struct {
+	CommandObjectID  types.String `tfsdk:"command_object_id"`
+	CommandName      types.String `tfsdk:"command_name"`
+	CommandParameter types.String `tfsdk:"command_parameter"`
+	Location         types.String `tfsdk:"location"`
+	Timeout          types.String `tfsdk:"timeout"`
+	NoWait           types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *schematicsJobRunAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_job"
+}
+
+func (a *schematicsJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a job of a Schematics action, such as an Ansible playbook run, waits for the job to complete and reports the job log summary. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"command_object_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Schematics action to run.",
+			},
+			"command_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schematics job command name, `ansible_playbook_run` or `ansible_playbook_check`. If not specified, defaults to `ansible_playbook_run`.",
+				Validators: []validator.String{
+					validate.StringOneOf(
+						schematicsv1.CreateJobOptions_CommandName_AnsiblePlaybookRun,
+						schematicsv1.CreateJobOptions_CommandName_AnsiblePlaybookCheck),
+				},
+			},
+			"command_parameter": schema.StringAttribute{
+				Required:    true,
+				Description: "Schematics job command parameter (playbook-name).",
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The location of the Schematics action, such as `us-south` or `eu-de`. If not specified, the region of the provider is used.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the job to complete, for example `60m` or `2h`. If not specified, defaults to `60m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the job is created without waiting for completion. Default: false",
+			},
+		},
+	}
+}
+
+func (a *schematicsJobRunAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureSchematicsAction(req, resp)
+}
+
+func (a *schematicsJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config schematicsJobRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionID := config.CommandObjectID.ValueString()
+	commandName := schematicsv1.CreateJobOptions_CommandName_AnsiblePlaybookRun
+	if !config.CommandName.IsNull() {
+		commandName = config.CommandName.ValueString()
+	}
+
+	// Parse timeout duration, default to 60 minutes
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	createJobOptions := &schematicsv1.CreateJobOptions{}
+	createJobOptions.SetCommandObject(schematicsv1.CreateJobOptions_CommandObject_Action)
+	createJobOptions.SetCommandObjectID(actionID)
+	createJobOptions.SetCommandName(commandName)
+	createJobOptions.SetCommandParameter(config.CommandParameter.ValueString())
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running %s job of Schematics action '%s'...", commandName, actionID),
+	})
+
+	runSchematicsJob(ctx, a.session, config.Location.ValueString(), createJobOptions, timeout, config.NoWait.ValueBool(), resp)
+}
+
+// configureSchematicsAction returns the provider client session of a Schematics action.
+func configureSchematicsAction(req action.ConfigureRequest, resp *action.ConfigureResponse) conns.ClientSession {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return nil
+	}
+
+	return session
+}
+
+// runSchematicsJob creates the Schematics job in the given location and, unless noWait is set, waits for the job
+// to complete while reporting the job status and log summary as progress.
+func runSchematicsJob(ctx context.Context, session conns.ClientSession, location string, createJobOptions *schematicsv1.CreateJobOptions, timeout time.Duration, noWait bool, resp *action.InvokeResponse) {
+	schematicsClient, err := session.SchematicsV1()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Schematics Client",
+			"An unexpected error occurred when creating the Schematics client.\n\n"+
+				"Schematics Client Error: "+err.Error(),
+		)
+		return
+	}
+	bmxSession, err := session.BluemixSession()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Schematics Client",
+			"An unexpected error occurred when reading the IAM refresh token.\n\n"+
+				"Bluemix Session Error: "+err.Error(),
+		)
+		return
+	}
+	if location != "" {
+		schematicsURL, updatedURL, _ := SchematicsEndpointURL(location, session)
+		if updatedURL {
+			// clone the client so that the location does not leak into the provider's client
+			schematicsClient = &schematicsv1.SchematicsV1{
+				Service: schematicsClient.Service.Clone(),
+			}
+			schematicsClient.Service.SetServiceURL(schematicsURL)
+		}
+		createJobOptions.SetLocation(location)
+	}
+	createJobOptions.SetRefreshToken(bmxSession.Config.IAMRefreshToken)
+
+	job, response, err := schematicsClient.CreateJobWithContext(ctx, createJobOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateJobWithContext failed %s\n%s", err, response)
+		resp.Diagnostics.AddError(
+			"Failed to Create Schematics Job",
+			fmt.Sprintf("Failed to create %s job for '%s': %s", *createJobOptions.CommandName, *createJobOptions.CommandObjectID, err.Error()),
+		)
+		return
+	}
+	jobID := *job.ID
+
+	// Return immediately if no_wait set to true
+	if noWait {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Schematics job '%s' submitted (no-wait mode)", jobID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for Schematics job '%s' to complete (timeout: %v)...", jobID, timeout),
+	})
+
+	_, err = waitForSchematicsJobCompleted(ctx, schematicsClient, jobID, timeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Schematics Job Failed",
+			fmt.Sprintf("Schematics job '%s' did not complete successfully: %s", jobID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Schematics job '%s' completed successfully", jobID),
+	})
+}
+
+// schematicsJobStatus returns the status code and message of a workspace or an action job.
+func schematicsJobStatus(job *schematicsv1.Job) (string, string) {
+	statusCode, statusMessage := "", ""
+	if job.Status != nil {
+		if s := job.Status.WorkspaceJobStatus; s != nil {
+			if s.StatusCode != nil {
+				statusCode = *s.StatusCode
+			}
+			if s.StatusMessage != nil {
+				statusMessage = *s.StatusMessage
+			}
+		} else if s := job.Status.ActionJobStatus; s != nil {
+			if s.StatusCode != nil {
+				statusCode = *s.StatusCode
+			}
+			if s.StatusMessage != nil {
+				statusMessage = *s.StatusMessage
+			}
+		}
+	}
+	return statusCode, statusMessage
+}
+
+// schematicsJobLogSummary formats the log summary of a workspace or an action job, one line per entry.
+func schematicsJobLogSummary(summary *schematicsv1.JobLogSummary) []string {
+	if summary == nil {
+		return nil
+	}
+	var lines []string
+	if w := summary.WorkspaceJob; w != nil {
+		lines = append(lines, fmt.Sprintf("Resources: %s to add, %s to change, %s to destroy",
+			formatLogSummaryCount(w.ResourcesAdd), formatLogSummaryCount(w.ResourcesModify), formatLogSummaryCount(w.ResourcesDestroy)))
+	}
+	if a := summary.ActionJob; a != nil {
+		lines = append(lines, fmt.Sprintf("Targets: %s, plays: %s, tasks: %s",
+			formatLogSummaryCount(a.TargetCount), formatLogSummaryCount(a.PlayCount), formatLogSummaryCount(a.TaskCount)))
+		if r := a.Recap; r != nil {
+			lines = append(lines, fmt.Sprintf("Recap: ok=%s changed=%s failed=%s skipped=%s unreachable=%s",
+				formatLogSummaryCount(r.Ok), formatLogSummaryCount(r.Changed), formatLogSummaryCount(r.Failed),
+				formatLogSummaryCount(r.Skipped), formatLogSummaryCount(r.Unreachable)))
+		}
+	}
+	for _, logError := range summary.LogErrors {
+		errorCode, errorMsg := "", ""
+		if logError.ErrorCode != nil {
+			errorCode = *logError.ErrorCode
+		}
+		if logError.ErrorMsg != nil {
+			errorMsg = *logError.ErrorMsg
+		}
+		lines = append(lines, fmt.Sprintf("Error %s (%s occurrences): %s", errorCode, formatLogSummaryCount(logError.ErrorCount), errorMsg))
+	}
+	return lines
+}
+
+func formatLogSummaryCount(count *float64) string {
+	if count == nil {
+		return "0"
+	}
+	return fmt.Sprintf("%.0f", *count)
+}
+
+func waitForSchematicsJobCompleted(ctx context.Context, client *schematicsv1.SchematicsV1, jobID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) (interface{}, error) {
+	log.Printf("Waiting for Schematics job (%s) to complete.", jobID)
+	lastStatus := ""
+	reported := map[string]bool{}
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{schematicsJobPending},
+		Target:  []string{schematicsJobDone},
+		Refresh: func() (interface{}, string, error) {
+			job, response, err := client.GetJobWithContext(ctx, &schematicsv1.GetJobOptions{
+				JobID: &jobID,
+			})
+			if err != nil {
+				log.Printf("[DEBUG] GetJobWithContext failed %s\n%s", err, response)
+				return nil, "", fmt.Errorf("error getting Schematics job: %s", err)
+			}
+			statusCode, statusMessage := schematicsJobStatus(job)
+			if statusCode != "" && statusCode != lastStatus {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Schematics job status: %s", statusCode),
+				})
+				lastStatus = statusCode
+			}
+			// stream each new line of the log summary once
+			for _, line := range schematicsJobLogSummary(job.LogSummary) {
+				if !reported[line] {
+					sendProgress(action.InvokeProgressEvent{Message: line})
+					reported[line] = true
+				}
+			}
+			switch statusCode {
+			case schematicsv1.JobStatusWorkspace_StatusCode_JobFinished:
+				return job, schematicsJobDone, nil
+			case schematicsv1.JobStatusWorkspace_StatusCode_JobFailed,
+				schematicsv1.JobStatusWorkspace_StatusCode_JobCancelled,
+				schematicsv1.JobStatusWorkspace_StatusCode_JobStopped:
+				return job, statusCode, fmt.Errorf("job ended with status %s: %s", statusCode, strings.TrimSpace(statusMessage))
+			}
+			return job, schematicsJobPending, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func TestAccIBMSchematicsJobActionInvokePlaybookRun(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsJobActionInvokeConfig(acc.ActionID, "ansible_playbook_run", "ssh_user.yml"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMSchematicsLatestJobFinished(&schematicsv1.ListJobsOptions{
+						Resource: core.StringPtr(schematicsv1.ListJobsOptions_Resource_Action),
+						ActionID: core.StringPtr(acc.ActionID),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsJobActionInvokeInvalidCommandName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsJobActionInvokeConfig(acc.ActionID, "workspace_apply", "ssh_user.yml"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsJobActionInvokeConfig(actionID, commandName, commandParameter string) string {
+	return fmt.Sprintf(`
+action "ibm_schematics_job" "run" {
+	config {
+		command_object_id = "%s"
+		command_name      = "%s"
+		command_parameter = "%s"
+		location          = "us-east"
+	}
+}
+
+resource "null_resource" "trigger" {
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.ibm_schematics_job.run]
+		}
+	}
+}
+`, actionID, commandName, commandParameter)
+}
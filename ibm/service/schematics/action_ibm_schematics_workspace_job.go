@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &schematicsWorkspaceJobAction{}
+	_ action.ActionWithConfigure = &schematicsWorkspaceJobAction{}
+)
+
+// schematicsWorkspaceCommands maps the workspace commands to the Schematics job command names.
+var schematicsWorkspaceCommands = map[string]string{
+	"plan":    schematicsv1.CreateJobOptions_CommandName_WorkspacePlan,
+	"apply":   schematicsv1.CreateJobOptions_CommandName_WorkspaceApply,
+	"destroy": schematicsv1.CreateJobOptions_CommandName_WorkspaceDestroy,
+}
+
+func NewSchematicsWorkspaceJobAction() action.Action {
+	return &schematicsWorkspaceJobAction{}
+}
+
+type schematicsWorkspaceJobAction struct {
+	session conns.ClientSession
+}
+
+type schematicsWorkspaceJobActionModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Command     types.String `tfsdk:"command"`
+	Location    types.String `tfsdk:"location"`
+	Timeout     types.String `tfsdk:"timeout"`
+	NoWait      types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *schematicsWorkspaceJobAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_schematics_workspace_job"
+}
+
+func (a *schematicsWorkspaceJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Terraform plan, apply or destroy job of a Schematics workspace, waits for the job to complete and reports the job log summary. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Schematics workspace.",
+			},
+			"command": schema.StringAttribute{
+				Required:    true,
+				Description: "The Terraform command to run in the workspace, one of `plan`, `apply` or `destroy`.",
+				Validators: []validator.String{
+					validate.StringOneOf("plan", "apply", "destroy"),
+				},
+			},
+			"location": schema.StringAttribute{
+				Optional:    true,
+				Description: "The location of the Schematics workspace, such as `us-south` or `eu-de`. If not specified, the region of the provider is used.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the job to complete, for example `60m` or `2h`. If not specified, defaults to `60m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the job is created without waiting for completion. Default: false",
+			},
+		},
+	}
+}
+
+func (a *schematicsWorkspaceJobAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.session = configureSchematicsAction(req, resp)
+}
+
+func (a *schematicsWorkspaceJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config schematicsWorkspaceJobActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := config.WorkspaceID.ValueString()
+	command := config.Command.ValueString()
+
+	// Parse timeout duration, default to 60 minutes
+	timeout := 60 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	createJobOptions := &schematicsv1.CreateJobOptions{}
+	createJobOptions.SetCommandObject(schematicsv1.CreateJobOptions_CommandObject_Workspace)
+	createJobOptions.SetCommandObjectID(workspaceID)
+	createJobOptions.SetCommandName(schematicsWorkspaceCommands[command])
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running %s job of Schematics workspace '%s'...", command, workspaceID),
+	})
+
+	runSchematicsJob(ctx, a.session, config.Location.ValueString(), createJobOptions, timeout, config.NoWait.ValueBool(), resp)
+}
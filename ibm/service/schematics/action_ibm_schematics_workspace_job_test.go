@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+func TestAccIBMSchematicsWorkspaceJobActionInvokePlan(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceJobActionInvokeConfig(acc.WorkspaceID, "plan"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMSchematicsLatestJobFinished(&schematicsv1.ListJobsOptions{
+						Resource:    core.StringPtr(schematicsv1.ListJobsOptions_Resource_Workspaces),
+						WorkspaceID: core.StringPtr(acc.WorkspaceID),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMSchematicsWorkspaceJobActionInvokeInvalidCommand(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMSchematicsWorkspaceJobActionInvokeConfig(acc.WorkspaceID, "refresh"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMSchematicsLatestJobFinished checks that the most recently submitted job of the listed jobs finished
+func testAccCheckIBMSchematicsLatestJobFinished(listJobsOptions *schematicsv1.ListJobsOptions) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		schematicsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SchematicsV1()
+		if err != nil {
+			return err
+		}
+
+		jobList, _, err := schematicsClient.ListJobs(listJobsOptions)
+		if err != nil {
+			return fmt.Errorf("Error listing Schematics jobs: %s", err)
+		}
+
+		var latest *schematicsv1.JobLite
+		for i, job := range jobList.Jobs {
+			if job.SubmittedAt == nil {
+				continue
+			}
+			if latest == nil || time.Time(*job.SubmittedAt).After(time.Time(*latest.SubmittedAt)) {
+				latest = &jobList.Jobs[i]
+			}
+		}
+		if latest == nil || latest.Status == nil {
+			return fmt.Errorf("No Schematics job found")
+		}
+
+		statusCode := ""
+		if latest.Status.WorkspaceJobStatus != nil && latest.Status.WorkspaceJobStatus.StatusCode != nil {
+			statusCode = *latest.Status.WorkspaceJobStatus.StatusCode
+		} else if latest.Status.ActionJobStatus != nil && latest.Status.ActionJobStatus.StatusCode != nil {
+			statusCode = *latest.Status.ActionJobStatus.StatusCode
+		}
+		if statusCode != schematicsv1.JobStatusWorkspace_StatusCode_JobFinished {
+			return fmt.Errorf("Expected Schematics job '%s' status %s, got %s", *latest.ID, schematicsv1.JobStatusWorkspace_StatusCode_JobFinished, statusCode)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMSchematicsWorkspaceJobActionInvokeConfig(workspaceID, command string) string {
+	return fmt.Sprintf(`
+action "ibm_schematics_workspace_job" "run" {
+	config {
+		workspace_id = "%s"
+		command      = "%s"
+		location     = "us-south"
+	}
+}
+
+resource "null_resource" "trigger" {
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.ibm_schematics_workspace_job.run]
+		}
+	}
+}
+`, workspaceID, command)
+}
@@ -0,0 +1,402 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	smSecretRotatePending = "pending"
+	smSecretRotateActive  = "active"
+)
+
+var (
+	_ action.Action              = &smSecretRotateAction{}
+	_ action.ActionWithConfigure = &smSecretRotateAction{}
+)
+
+func NewSmSecretRotateAction() action.Action {
+	return &smSecretRotateAction{}
+}
+
+type smSecretRotateAction struct {
+	session conns.ClientSession
+}
+
+type smSecretRotateActionModel struct {
+	InstanceID   types.String `tfsdk:"instance_id"`
+	Region       types.String `tfsdk:"region"`
+	EndpointType types.String `tfsdk:"endpoint_type"`
+	SecretID     types.String `tfsdk:"secret_id"`
+	Payload      types.String `tfsdk:"payload"`
+	Password     types.String `tfsdk:"password"`
+	Certificate  types.String `tfsdk:"certificate"`
+	Intermediate types.String `tfsdk:"intermediate"`
+	PrivateKey   types.String `tfsdk:"private_key"`
+	Csr          types.String `tfsdk:"csr"`
+	Timeout      types.String `tfsdk:"timeout"`
+	NoWait       types.Bool   `tfsdk:"no_wait"`
+}
+
+// smSecretRotateArgument describes a secret type specific argument of the rotation.
+type smSecretRotateArgument struct {
+	attribute string
+	value     types.String
+	required  bool
+}
+
+func (a *smSecretRotateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = SecretRotateActionName
+}
+
+func (a *smSecretRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates an arbitrary, username/password, IAM credentials, imported certificate or private certificate secret in place by creating a new secret version, and optionally waits for the new version to become active. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Secrets Manager instance.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region of the Secrets Manager instance. If not specified, the region of the provider is used.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "public or private.",
+				Validators: []validator.String{
+					validate.StringOneOf("public", "private"),
+				},
+			},
+			"secret_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the secret to rotate.",
+			},
+			"payload": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new data payload of an arbitrary secret. Required for arbitrary secrets.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new password of a username/password secret. If not specified, Secrets Manager generates a new password.",
+			},
+			"certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The new PEM-encoded certificate of an imported certificate. Required for imported certificates.",
+			},
+			"intermediate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The new PEM-encoded intermediate certificate of an imported certificate.",
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The new PEM-encoded private key of an imported certificate.",
+			},
+			"csr": schema.StringAttribute{
+				Optional:    true,
+				Description: "The certificate signing request used to rotate a private certificate. If not specified, Secrets Manager generates a new private key.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the new secret version to become active, for example `10m` or `1h`. If not specified, defaults to `10m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the new secret version is created without waiting for it to become active. Default: false",
+			},
+		},
+	}
+}
+
+func (a *smSecretRotateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *smSecretRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config smSecretRotateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretId := config.SecretID.ValueString()
+
+	// Parse timeout duration, default to 10 minutes
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(a.session)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Secrets Manager Client",
+			"An unexpected error occurred when creating the Secrets Manager client.\n\n"+
+				"Secrets Manager Client Error: "+err.Error(),
+		)
+		return
+	}
+	region := config.Region.ValueString()
+	if region == "" {
+		region = getRegionFromServiceURL(secretsManagerClient)
+	}
+	endpointType := config.EndpointType.ValueString()
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromServiceURL(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, config.InstanceID.ValueString(), region, endpointType, endpointsFile)
+
+	getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+	getSecretMetadataOptions.SetID(secretId)
+	secretMetadataIntf, response, err := secretsManagerClient.GetSecretMetadataWithContext(ctx, getSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+		resp.Diagnostics.AddError(
+			"Failed to Get Secret",
+			fmt.Sprintf("Failed to get secret '%s': %s", secretId, err.Error()),
+		)
+		return
+	}
+	secretMetadata, err := toSecretMetadata(secretMetadataIntf)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Secret", fmt.Sprintf("Failed to read metadata of secret '%s': %s", secretId, err.Error()))
+		return
+	}
+	secretType := *secretMetadata.SecretType
+
+	var versionModel secretsmanagerv2.SecretVersionPrototypeIntf
+	var arguments []smSecretRotateArgument
+	switch secretType {
+	case ArbitrarySecretType:
+		arguments = []smSecretRotateArgument{{"payload", config.Payload, true}}
+		versionModel = &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload: config.Payload.ValueStringPointer(),
+		}
+	case UsernamePasswordSecretType:
+		arguments = []smSecretRotateArgument{{"password", config.Password, false}}
+		versionModel = &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+			Password: config.Password.ValueStringPointer(),
+		}
+	case IAMCredentialsSecretType:
+		versionModel = &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{}
+	case ImportedCertSecretType:
+		arguments = []smSecretRotateArgument{
+			{"certificate", config.Certificate, true},
+			{"intermediate", config.Intermediate, false},
+			{"private_key", config.PrivateKey, false},
+		}
+		versionModel = &secretsmanagerv2.ImportedCertificateVersionPrototype{
+			Certificate:  config.Certificate.ValueStringPointer(),
+			Intermediate: config.Intermediate.ValueStringPointer(),
+			PrivateKey:   config.PrivateKey.ValueStringPointer(),
+		}
+	case PrivateCertSecretType:
+		arguments = []smSecretRotateArgument{{"csr", config.Csr, false}}
+		versionModel = &secretsmanagerv2.PrivateCertificateVersionPrototype{
+			Csr: config.Csr.ValueStringPointer(),
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_id"),
+			"Unsupported Secret Type",
+			fmt.Sprintf("Secret '%s' has type %s, the action rotates only %s, %s, %s, %s and %s secrets", secretId, secretType,
+				ArbitrarySecretType, UsernamePasswordSecretType, IAMCredentialsSecretType, ImportedCertSecretType, PrivateCertSecretType),
+		)
+		return
+	}
+	validateSecretRotateArguments(config, secretType, arguments, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotating %s secret '%s'...", secretType, secretId),
+	})
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretId)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionModel)
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(ctx, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secret",
+			fmt.Sprintf("Failed to create a new version of secret '%s': %s", secretId, err.Error()),
+		)
+		return
+	}
+	secretVersion, err := toSecretVersionMetadata(secretVersionIntf)
+	if err != nil || secretVersion.ID == nil {
+		resp.Diagnostics.AddError("Failed to Rotate Secret", fmt.Sprintf("Failed to read the new version of secret '%s': %v", secretId, err))
+		return
+	}
+	versionId := *secretVersion.ID
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Secret '%s' version '%s' created (no-wait mode)", secretId, versionId),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for version '%s' of secret '%s' to become active (timeout: %v)...", versionId, secretId, timeout),
+	})
+
+	_, err = waitForSmSecretVersionActive(ctx, secretsManagerClient, secretId, versionId, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Secret Rotation Failed",
+			fmt.Sprintf("Failed waiting for version '%s' of secret '%s' to become active: %s", versionId, secretId, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secret '%s' rotated successfully, version '%s' is active", secretId, versionId),
+	})
+}
+
+// validateSecretRotateArguments ensures that the required arguments of the secret type are set and that no
+// argument of another secret type is set.
+func validateSecretRotateArguments(config smSecretRotateActionModel, secretType string, arguments []smSecretRotateArgument, diags *diag.Diagnostics) {
+	accepted := map[string]bool{}
+	for _, argument := range arguments {
+		accepted[argument.attribute] = true
+		if argument.required && argument.value.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root(argument.attribute),
+				"Missing Secret Rotation Argument",
+				fmt.Sprintf("%s is required to rotate a %s secret", argument.attribute, secretType),
+			)
+		}
+	}
+	for _, argument := range []smSecretRotateArgument{
+		{attribute: "payload", value: config.Payload},
+		{attribute: "password", value: config.Password},
+		{attribute: "certificate", value: config.Certificate},
+		{attribute: "intermediate", value: config.Intermediate},
+		{attribute: "private_key", value: config.PrivateKey},
+		{attribute: "csr", value: config.Csr},
+	} {
+		if !accepted[argument.attribute] && !argument.value.IsNull() {
+			diags.AddAttributeError(
+				path.Root(argument.attribute),
+				"Unsupported Secret Rotation Argument",
+				fmt.Sprintf("%s cannot be used to rotate a %s secret", argument.attribute, secretType),
+			)
+		}
+	}
+}
+
+// toSecretMetadata converts a secret metadata of any secret type to the fields common to all secret types.
+func toSecretMetadata(secretMetadataIntf secretsmanagerv2.SecretMetadataIntf) (*secretsmanagerv2.SecretMetadata, error) {
+	secretMetadata := &secretsmanagerv2.SecretMetadata{}
+	data, err := json.Marshal(secretMetadataIntf)
+	if err == nil {
+		err = json.Unmarshal(data, secretMetadata)
+	}
+	if err == nil && secretMetadata.SecretType == nil {
+		err = fmt.Errorf("missing secret type")
+	}
+	return secretMetadata, err
+}
+
+// toSecretVersionMetadata converts a secret version, or a secret version metadata, of any secret type to the
+// version metadata fields common to all secret types.
+func toSecretVersionMetadata(secretVersionIntf interface{}) (*secretsmanagerv2.SecretVersionMetadata, error) {
+	secretVersionMetadata := &secretsmanagerv2.SecretVersionMetadata{}
+	data, err := json.Marshal(secretVersionIntf)
+	if err == nil {
+		err = json.Unmarshal(data, secretVersionMetadata)
+	}
+	return secretVersionMetadata, err
+}
+
+func waitForSmSecretVersionActive(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretId, versionId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for version (%s) of secret (%s) to become active.", versionId, secretId)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{smSecretRotatePending},
+		Target:  []string{smSecretRotateActive},
+		Refresh: func() (interface{}, string, error) {
+			getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+			getSecretVersionMetadataOptions.SetSecretID(secretId)
+			getSecretVersionMetadataOptions.SetID("current")
+			currentVersionIntf, response, err := client.GetSecretVersionMetadataWithContext(ctx, getSecretVersionMetadataOptions)
+			if err != nil {
+				log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+				return nil, "", fmt.Errorf("error getting current secret version: %s", err)
+			}
+			currentVersion, err := toSecretVersionMetadata(currentVersionIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if currentVersion.ID == nil || *currentVersion.ID != versionId || (currentVersion.PayloadAvailable != nil && !*currentVersion.PayloadAvailable) {
+				return currentVersion, smSecretRotatePending, nil
+			}
+
+			getSecretMetadataOptions := &secretsmanagerv2.GetSecretMetadataOptions{}
+			getSecretMetadataOptions.SetID(secretId)
+			secretMetadataIntf, response, err := client.GetSecretMetadataWithContext(ctx, getSecretMetadataOptions)
+			if err != nil {
+				log.Printf("[DEBUG] GetSecretMetadataWithContext failed %s\n%s", err, response)
+				return nil, "", fmt.Errorf("error getting secret: %s", err)
+			}
+			secretMetadata, err := toSecretMetadata(secretMetadataIntf)
+			if err != nil {
+				return nil, "", err
+			}
+			if secretMetadata.StateDescription == nil {
+				return secretMetadata, smSecretRotatePending, nil
+			}
+			switch *secretMetadata.StateDescription {
+			case secretsmanagerv2.SecretMetadata_StateDescription_Active:
+				return secretMetadata, smSecretRotateActive, nil
+			case secretsmanagerv2.SecretMetadata_StateDescription_PreActivation:
+				return secretMetadata, smSecretRotatePending, nil
+			}
+			return secretMetadata, *secretMetadata.StateDescription, fmt.Errorf("secret is in %s state", *secretMetadata.StateDescription)
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretRotateActionArbitrarySecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSmSecretRotateActionArbitrarySecretConfig("public"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIbmSmSecretRotateActionRotated("ibm_sm_arbitrary_secret.sm_arbitrary_secret_rotate", "rotated-credentials"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmSecretRotateActionUsernamePasswordSecret(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSmSecretRotateActionUsernamePasswordSecretConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIbmSmSecretRotateActionRotated("ibm_sm_username_password_secret.sm_username_password_secret_rotate", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIbmSmSecretRotateActionInvalidEndpointType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIbmSmSecretRotateActionArbitrarySecretConfig("direct"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+// testAccCheckIbmSmSecretRotateActionRotated checks that the secret has a second version and, for an arbitrary
+// secret, that the payload of the current version is the given payload
+func testAccCheckIbmSmSecretRotateActionRotated(n, payload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		secretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+
+		switch secret := secretIntf.(type) {
+		case *secretsmanagerv2.ArbitrarySecret:
+			if err := verifyIntAttr(int(*secret.VersionsTotal), 2, "versions_total"); err != nil {
+				return err
+			}
+			return verifyAttr(*secret.Payload, payload, "payload")
+		case *secretsmanagerv2.UsernamePasswordSecret:
+			return verifyIntAttr(int(*secret.VersionsTotal), 2, "versions_total")
+		}
+		return fmt.Errorf("Unexpected secret type %T", secretIntf)
+	}
+}
+
+func testAccCheckIbmSmSecretRotateActionArbitrarySecretConfig(endpointType string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret_rotate" {
+			name            = "test_arbitrary_secret_rotate_terraform"
+			instance_id     = "%s"
+			region          = "%s"
+			payload         = "secret-credentials"
+			secret_group_id = "default"
+		}
+
+		action "ibm_sm_secret_rotate" "rotate" {
+			config {
+				instance_id   = "%s"
+				region        = "%s"
+				endpoint_type = "%s"
+				secret_id     = ibm_sm_arbitrary_secret.sm_arbitrary_secret_rotate.secret_id
+				payload       = "rotated-credentials"
+			}
+		}
+
+		resource "null_resource" "trigger" {
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.ibm_sm_secret_rotate.rotate]
+				}
+			}
+			depends_on = [ibm_sm_arbitrary_secret.sm_arbitrary_secret_rotate]
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, endpointType)
+}
+
+func testAccCheckIbmSmSecretRotateActionUsernamePasswordSecretConfig() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_rotate" {
+			name        = "test_username_password_secret_rotate_terraform"
+			instance_id = "%s"
+			region      = "%s"
+			username    = "%s"
+			password    = "%s"
+		}
+
+		action "ibm_sm_secret_rotate" "rotate" {
+			config {
+				instance_id = "%s"
+				region      = "%s"
+				secret_id   = ibm_sm_username_password_secret.sm_username_password_secret_rotate.secret_id
+			}
+		}
+
+		resource "null_resource" "trigger" {
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.ibm_sm_secret_rotate.rotate]
+				}
+			}
+			depends_on = [ibm_sm_username_password_secret.sm_username_password_secret_rotate]
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, username, password, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
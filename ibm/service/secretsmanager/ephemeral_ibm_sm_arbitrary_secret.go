@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smArbitrarySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smArbitrarySecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smArbitrarySecretEphemeralResource{}
+)
+
+func NewSmArbitrarySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smArbitrarySecretEphemeralResource{}
+}
+
+type smArbitrarySecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smArbitrarySecretEphemeralModel struct {
+	smSecretLookupModel
+	Payload types.String `tfsdk:"payload"`
+}
+
+func (e *smArbitrarySecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ArbitrarySecretResourceName
+}
+
+func (e *smArbitrarySecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = smSecretEphemeralSchema(
+		"Retrieves the payload of an arbitrary secret without persisting it to the Terraform plan or state.",
+		map[string]schema.Attribute{
+			"payload": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The arbitrary secret's data payload.",
+			},
+		},
+	)
+}
+
+func (e *smArbitrarySecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSecretLookupConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (e *smArbitrarySecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smArbitrarySecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretIntf := e.openSecret(ctx, &data.smSecretLookupModel, ArbitrarySecretType, ArbitrarySecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	arbitrarySecret, ok := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
+	if !ok {
+		wrongSecretTypeError(ArbitrarySecretResourceName, "an arbitrary", &resp.Diagnostics)
+		return
+	}
+
+	data.setSecretIdentity(arbitrarySecret.ID, arbitrarySecret.Name)
+	data.Payload = types.StringPointerValue(arbitrarySecret.Payload)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smIamCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smIamCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smIamCredentialsSecretEphemeralResource{}
+)
+
+func NewSmIamCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smIamCredentialsSecretEphemeralResource{}
+}
+
+type smIamCredentialsSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smIamCredentialsSecretEphemeralModel struct {
+	smSecretLookupModel
+	ApiKey    types.String `tfsdk:"api_key"`
+	ApiKeyID  types.String `tfsdk:"api_key_id"`
+	ServiceID types.String `tfsdk:"service_id"`
+}
+
+func (e *smIamCredentialsSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = IAMCredentialsSecretResourceName
+}
+
+func (e *smIamCredentialsSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = smSecretEphemeralSchema(
+		"Retrieves the API key of an IAM credentials secret without persisting it to the Terraform plan or state.",
+		map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The service ID under which the API key is created.",
+			},
+		},
+	)
+}
+
+func (e *smIamCredentialsSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSecretLookupConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (e *smIamCredentialsSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smIamCredentialsSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretIntf := e.openSecret(ctx, &data.smSecretLookupModel, IAMCredentialsSecretType, IAMCredentialsSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iAMCredentialsSecret, ok := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
+	if !ok {
+		wrongSecretTypeError(IAMCredentialsSecretResourceName, "an IAM Credentials", &resp.Diagnostics)
+		return
+	}
+
+	data.setSecretIdentity(iAMCredentialsSecret.ID, iAMCredentialsSecret.Name)
+	data.ApiKey = types.StringPointerValue(iAMCredentialsSecret.ApiKey)
+	data.ApiKeyID = types.StringPointerValue(iAMCredentialsSecret.ApiKeyID)
+	data.ServiceID = types.StringPointerValue(iAMCredentialsSecret.ServiceID)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmIamCredentialsSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmIamCredentialsSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret_instance", "secret_id"),
+					testAccCheckIbmSmEphemeralResourceNotInState("ibm_sm_iam_credentials_secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmIamCredentialsSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret_instance" {
+			name = "iam-credentials-ephemeral-test-terraform"
+			instance_id   = "%s"
+			region        = "%s"
+			service_id = "%s"
+			ttl = "1800"
+			reuse_api_key = true
+		}
+
+		ephemeral "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_iam_credentials_secret.sm_iam_credentials_secret_instance.secret_id
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerIamCredentialsSecretServiceId, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
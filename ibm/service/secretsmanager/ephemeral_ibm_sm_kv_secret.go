@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smKvSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smKvSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smKvSecretEphemeralResource{}
+)
+
+func NewSmKvSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smKvSecretEphemeralResource{}
+}
+
+type smKvSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smKvSecretEphemeralModel struct {
+	smSecretLookupModel
+	Data types.Map `tfsdk:"data"`
+}
+
+func (e *smKvSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = KvSecretResourceName
+}
+
+func (e *smKvSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = smSecretEphemeralSchema(
+		"Retrieves the payload of a key-value secret without persisting it to the Terraform plan or state.",
+		map[string]schema.Attribute{
+			"data": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The payload data of a key-value secret.",
+			},
+		},
+	)
+}
+
+func (e *smKvSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSecretLookupConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (e *smKvSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smKvSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretIntf := e.openSecret(ctx, &data.smSecretLookupModel, KvSecretType, KvSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kVSecret, ok := secretIntf.(*secretsmanagerv2.KVSecret)
+	if !ok {
+		wrongSecretTypeError(KvSecretResourceName, "a key-value", &resp.Diagnostics)
+		return
+	}
+
+	data.setSecretIdentity(kVSecret.ID, kVSecret.Name)
+
+	// Nested values are flattened the same way as in the ibm_sm_kv_secret data source
+	payload, diags := types.MapValueFrom(ctx, types.StringType, map[string]string(flex.Flatten(kVSecret.Data)))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Data = payload
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smServiceCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smServiceCredentialsSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smServiceCredentialsSecretEphemeralResource{}
+)
+
+func NewSmServiceCredentialsSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smServiceCredentialsSecretEphemeralResource{}
+}
+
+type smServiceCredentialsSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smServiceCredentialsSecretEphemeralModel struct {
+	smSecretLookupModel
+	Credentials types.Map `tfsdk:"credentials"`
+}
+
+func (e *smServiceCredentialsSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = ServiceCredentialsSecretResourceName
+}
+
+func (e *smServiceCredentialsSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = smSecretEphemeralSchema(
+		"Retrieves the credentials of a service credentials secret without persisting them to the Terraform plan or state.",
+		map[string]schema.Attribute{
+			"credentials": schema.MapAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "The properties of the service credentials secret payload.",
+			},
+		},
+	)
+}
+
+func (e *smServiceCredentialsSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSecretLookupConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (e *smServiceCredentialsSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smServiceCredentialsSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretIntf := e.openSecret(ctx, &data.smSecretLookupModel, ServiceCredentialsSecretType, ServiceCredentialsSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceCredentialsSecret, ok := secretIntf.(*secretsmanagerv2.ServiceCredentialsSecret)
+	if !ok {
+		wrongSecretTypeError(ServiceCredentialsSecretResourceName, "a service credentials", &resp.Diagnostics)
+		return
+	}
+
+	data.setSecretIdentity(serviceCredentialsSecret.ID, serviceCredentialsSecret.Name)
+
+	// The credentials are flattened the same way as in the ibm_sm_service_credentials_secret data source
+	var credInterface map[string]interface{}
+	if serviceCredentialsSecret.Credentials != nil {
+		cred, _ := json.Marshal(serviceCredentialsSecret.Credentials)
+		json.Unmarshal(cred, &credInterface)
+	}
+	credentials, diags := types.MapValueFrom(ctx, types.StringType, map[string]string(flex.Flatten(credInterface)))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Credentials = credentials
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &smUsernamePasswordSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &smUsernamePasswordSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &smUsernamePasswordSecretEphemeralResource{}
+)
+
+func NewSmUsernamePasswordSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &smUsernamePasswordSecretEphemeralResource{}
+}
+
+type smUsernamePasswordSecretEphemeralResource struct {
+	smSecretEphemeralResource
+}
+
+type smUsernamePasswordSecretEphemeralModel struct {
+	smSecretLookupModel
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (e *smUsernamePasswordSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = UsernamePasswordSecretResourceName
+}
+
+func (e *smUsernamePasswordSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = smSecretEphemeralSchema(
+		"Retrieves the credentials of a user credentials secret without persisting them to the Terraform plan or state.",
+		map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The username that is assigned to the secret.",
+			},
+			"password": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password that is assigned to the secret.",
+			},
+		},
+	)
+}
+
+func (e *smUsernamePasswordSecretEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	validateSecretLookupConfig(ctx, req.Config, &resp.Diagnostics)
+}
+
+func (e *smUsernamePasswordSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data smUsernamePasswordSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretIntf := e.openSecret(ctx, &data.smSecretLookupModel, UsernamePasswordSecretType, UsernamePasswordSecretResourceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usernamePasswordSecret, ok := secretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
+	if !ok {
+		wrongSecretTypeError(UsernamePasswordSecretResourceName, "a username_password", &resp.Diagnostics)
+		return
+	}
+
+	data.setSecretIdentity(usernamePasswordSecret.ID, usernamePasswordSecret.Name)
+	data.Username = types.StringPointerValue(usernamePasswordSecret.Username)
+	data.Password = types.StringPointerValue(usernamePasswordSecret.Password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
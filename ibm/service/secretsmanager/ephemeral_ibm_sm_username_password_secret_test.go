@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmUsernamePasswordSecretEphemeralResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret_instance", "secret_id"),
+					testAccCheckIbmSmEphemeralResourceNotInState("ibm_sm_username_password_secret"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretEphemeralResourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret_instance" {
+			name = "username_password-ephemeral-terraform-test"
+			instance_id   = "%s"
+			region        = "%s"
+			username = "username"
+			password = "password"
+			secret_group_id = "default"
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			instance_id = "%s"
+			region = "%s"
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret_instance.secret_id
+		}
+
+		ephemeral "ibm_sm_username_password_secret" "sm_username_password_secret_by_name" {
+			instance_id = "%s"
+			region = "%s"
+			name = ibm_sm_username_password_secret.sm_username_password_secret_instance.name
+			secret_group_name = "default"
+		}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
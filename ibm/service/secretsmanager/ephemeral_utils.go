@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// smSecretEphemeralResource holds the behavior shared by the Secrets Manager secret ephemeral resources:
+// provider configuration and the lookup of a secret by ID or by name and secret group name.
+type smSecretEphemeralResource struct {
+	session conns.ClientSession
+}
+
+// smSecretLookupModel describes the arguments used to locate a secret. It is embedded in the
+// model of every secret ephemeral resource.
+type smSecretLookupModel struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	Region          types.String `tfsdk:"region"`
+	EndpointType    types.String `tfsdk:"endpoint_type"`
+	SecretID        types.String `tfsdk:"secret_id"`
+	Name            types.String `tfsdk:"name"`
+	SecretGroupName types.String `tfsdk:"secret_group_name"`
+}
+
+// smSecretEphemeralSchema returns the schema of a secret ephemeral resource, composed of the lookup
+// attributes and the given payload attributes.
+func smSecretEphemeralSchema(description string, payloadAttributes map[string]schema.Attribute) schema.Schema {
+	attributes := map[string]schema.Attribute{
+		"instance_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the Secrets Manager instance.",
+		},
+		"region": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The region of the Secrets Manager instance.",
+		},
+		"endpoint_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "public or private.",
+		},
+		"secret_id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The human-readable name of your secret.",
+		},
+		"secret_group_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The human-readable name of your secret group.",
+		},
+	}
+	for name, attribute := range payloadAttributes {
+		attributes[name] = attribute
+	}
+	return schema.Schema{
+		Description: description,
+		Attributes:  attributes,
+	}
+}
+
+func (e *smSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.session = session
+}
+
+// validateSecretLookupConfig ensures that the secret is identified either by secret_id or by name and secret_group_name.
+func validateSecretLookupConfig(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var lookup smSecretLookupModel
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_id"), &lookup.SecretID)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("name"), &lookup.Name)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_group_name"), &lookup.SecretGroupName)...)
+	if diags.HasError() {
+		return
+	}
+
+	// Unknown values are validated again once they are known
+	if lookup.SecretID.IsUnknown() || lookup.Name.IsUnknown() || lookup.SecretGroupName.IsUnknown() {
+		return
+	}
+
+	if !lookup.SecretID.IsNull() && !lookup.Name.IsNull() {
+		diags.AddAttributeError(
+			path.Root("secret_id"),
+			"Conflicting Secret Identifiers",
+			"Only one of \"secret_id\" or \"name\" can be specified.",
+		)
+		return
+	}
+	if lookup.SecretID.IsNull() && (lookup.Name.IsNull() || lookup.SecretGroupName.IsNull()) {
+		diags.AddError(
+			"Missing Secret Identifier",
+			"Either \"secret_id\" or both \"name\" and \"secret_group_name\" must be specified.",
+		)
+	}
+}
+
+// openSecret retrieves the secret described by the lookup arguments and fills in the computed lookup attributes.
+func (e *smSecretEphemeralResource) openSecret(ctx context.Context, lookup *smSecretLookupModel, secretType string, resourceName string, diags *diag.Diagnostics) secretsmanagerv2.SecretIntf {
+	secretIntf, region, tfErr := lookupSecretByIdOrByName(ctx, e.session, secretLookup{
+		InstanceID:      lookup.InstanceID.ValueString(),
+		Region:          lookup.Region.ValueString(),
+		EndpointType:    lookup.EndpointType.ValueString(),
+		SecretID:        lookup.SecretID.ValueString(),
+		SecretName:      lookup.Name.ValueString(),
+		SecretGroupName: lookup.SecretGroupName.ValueString(),
+	}, secretType, fmt.Sprintf("(Ephemeral) %s", resourceName))
+	if tfErr != nil {
+		diags.AddError(tfErr.Error(), tfErr.GetConsoleMessage())
+		return nil
+	}
+
+	lookup.Region = types.StringValue(region)
+	return secretIntf
+}
+
+// setSecretIdentity fills in the computed secret_id and name attributes of the lookup model.
+func (lookup *smSecretLookupModel) setSecretIdentity(id, name *string) {
+	lookup.SecretID = types.StringPointerValue(id)
+	lookup.Name = types.StringPointerValue(name)
+}
+
+// wrongSecretTypeError adds the diagnostic reported when the located secret has an unexpected type.
+func wrongSecretTypeError(resourceName, secretTypeDescription string, diags *diag.Diagnostics) {
+	tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("Wrong secret type: The provided secret is not %s secret.", secretTypeDescription), fmt.Sprintf("(Ephemeral) %s", resourceName), "read")
+	diags.AddError(tfErr.Error(), tfErr.GetConsoleMessage())
+}
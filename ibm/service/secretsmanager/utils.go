@@ -56,6 +56,8 @@ const (
 	SecretGroupResourceName  = "ibm_sm_secret_group"
 	SecretGroupsResourceName = "ibm_sm_secret_groups"
 	SecretsResourceName      = "ibm_sm_secrets"
+
+	SecretRotateActionName = "ibm_sm_secret_rotate"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -63,27 +65,36 @@ func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.Reso
 	if ok {
 		return d.Get("region").(string)
 	} else {
-		// extract region from base URL (provider config)
-		// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
-		baseUrl := originalClient.Service.GetServiceURL()
-		u := strings.Replace(baseUrl, "private.", "", 1)
-		return strings.Split(u, ".")[1]
+		return getRegionFromServiceURL(originalClient)
 	}
 }
 
+// Extract the region from the base URL of the provider's Secrets Manager client
+func getRegionFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	// base url is like that : "https://<private.>secrets-manager.<region>.<rest of domain>"
+	baseUrl := originalClient.Service.GetServiceURL()
+	u := strings.Replace(baseUrl, "private.", "", 1)
+	return strings.Split(u, ".")[1]
+}
+
 // Clone the base secrets manager client and set the API endpoint per the instance
 func getEndpointType(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
 	_, ok := d.GetOk("endpoint_type")
 	if ok {
 		return d.Get("endpoint_type").(string)
 	} else {
-		baseUrl := originalClient.Service.GetServiceURL()
+		return getEndpointTypeFromServiceURL(originalClient)
+	}
+}
 
-		if strings.Contains(baseUrl, "private.") {
-			return "private"
-		} else {
-			return "public"
-		}
+// Extract the endpoint type from the base URL of the provider's Secrets Manager client
+func getEndpointTypeFromServiceURL(originalClient *secretsmanagerv2.SecretsManagerV2) string {
+	baseUrl := originalClient.Service.GetServiceURL()
+
+	if strings.Contains(baseUrl, "private.") {
+		return "private"
+	} else {
+		return "public"
 	}
 }
 
@@ -212,19 +223,58 @@ func DateTimeToRFC3339(dt *strfmt.DateTime) (s string) {
 }
 
 func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta interface{}, secretType string, dataSourceName string) (secretsmanagerv2.SecretIntf, string, string, diag.Diagnostics) {
+	lookup := secretLookup{
+		InstanceID:      d.Get("instance_id").(string),
+		SecretID:        d.Get("secret_id").(string),
+		SecretName:      d.Get("name").(string),
+		SecretGroupName: d.Get("secret_group_name").(string),
+	}
+	if region, ok := d.GetOk("region"); ok {
+		lookup.Region = region.(string)
+	}
+	if endpointType, ok := d.GetOk("endpoint_type"); ok {
+		lookup.EndpointType = endpointType.(string)
+	}
 
-	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
-	if err != nil {
-		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", dataSourceName), "read")
+	secretIntf, region, tfErr := lookupSecretByIdOrByName(context, meta.(conns.ClientSession), lookup, secretType, fmt.Sprintf("(Data) %s", dataSourceName))
+	if tfErr != nil {
 		return nil, "", "", tfErr.GetDiag()
 	}
-	region := getRegion(secretsManagerClient, d)
-	instanceId := d.Get("instance_id").(string)
-	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+	return secretIntf, region, lookup.InstanceID, nil
+}
+
+// secretLookup holds the arguments used to locate a secret either by ID or by name and secret group name
+type secretLookup struct {
+	InstanceID      string
+	Region          string
+	EndpointType    string
+	SecretID        string
+	SecretName      string
+	SecretGroupName string
+}
 
-	secretId := d.Get("secret_id").(string)
-	secretName := d.Get("name").(string)
-	groupName := d.Get("secret_group_name").(string)
+// lookupSecretByIdOrByName retrieves a secret, including its payload, from the Secrets Manager instance
+// described by the lookup. The region and endpoint type default to those of the provider configuration.
+// It returns the secret and the region of the instance.
+func lookupSecretByIdOrByName(context context.Context, clientSession conns.ClientSession, lookup secretLookup, secretType string, resourceName string) (secretsmanagerv2.SecretIntf, string, *flex.TerraformProblem) {
+
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, "", flex.TerraformErrorf(err, "", resourceName, "read")
+	}
+	region := lookup.Region
+	if region == "" {
+		region = getRegionFromServiceURL(secretsManagerClient)
+	}
+	endpointType := lookup.EndpointType
+	if endpointType == "" {
+		endpointType = getEndpointTypeFromServiceURL(secretsManagerClient)
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, lookup.InstanceID, region, endpointType, endpointsFile)
+
+	secretId := lookup.SecretID
+	secretName := lookup.SecretName
+	groupName := lookup.SecretGroupName
 
 	log.Printf("[DEBUG] getSecretByIdOrByName %q %q %q %q\n", secretId, secretName, groupName, secretType)
 
@@ -238,16 +288,14 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretWithContext failed %s\n%s", err, response), resourceName, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
 	if secretName != "" && groupName != "" {
 		// Locate secret by name
 		getSecretByNameOptions := &secretsmanagerv2.GetSecretByNameTypeOptions{}
-
 		getSecretByNameOptions.SetName(secretName)
 		getSecretByNameOptions.SetSecretType(secretType)
 		getSecretByNameOptions.SetSecretGroupName(groupName)
@@ -255,14 +303,12 @@ func getSecretByIdOrByName(context context.Context, d *schema.ResourceData, meta
 		secretIntf, response, err = secretsManagerClient.GetSecretByNameTypeWithContext(context, getSecretByNameOptions)
 		if err != nil {
 			log.Printf("[DEBUG] GetSecretByNameTypeWithContext failed %s\n%s", err, response)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-			return nil, "", "", tfErr.GetDiag()
+			return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("GetSecretByNameTypeWithContext failed %s\n%s", err, response), resourceName, "read")
 		}
-		return secretIntf, region, instanceId, nil
+		return secretIntf, region, nil
 	}
 
-	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), fmt.Sprintf("(Data) %s", dataSourceName), "read")
-	return nil, "", "", tfErr.GetDiag()
+	return nil, "", flex.TerraformErrorf(err, fmt.Sprintf("Missing required arguments. Please make sure that either \"secret_id\" or \"name\" and \"secret_group_name\" are provided\n"), resourceName, "read")
 }
 
 func secretVersionMetadataAsPatchFunction(secretVersionMetadataPatch *secretsmanagerv2.SecretVersionMetadataPatch) (_patch map[string]interface{}, err error) {
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	tgConnectionActionPending = "pending"
+	tgConnectionActionDone    = "done"
+	tgConnectionDeleted       = "deleted"
+)
+
+var (
+	_ action.Action              = &tgConnectionApprovalAction{}
+	_ action.ActionWithConfigure = &tgConnectionApprovalAction{}
+)
+
+func NewTransitGatewayConnectionAction() action.Action {
+	return &tgConnectionApprovalAction{}
+}
+
+type tgConnectionApprovalAction struct {
+	client *transitgatewayapisv1.TransitGatewayApisV1
+}
+
+type tgConnectionApprovalActionModel struct {
+	Gateway      types.String `tfsdk:"gateway"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	Action       types.String `tfsdk:"action"`
+	Timeout      types.String `tfsdk:"timeout"`
+	NoWait       types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *tgConnectionApprovalAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_tg_connection_action"
+}
+
+func (a *tgConnectionApprovalAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Approves or rejects a pending cross-account Transit Gateway connection and waits until the connection leaves the pending state. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			tgXacGatewayId: schema.StringAttribute{
+				Required:    true,
+				Description: "The Transit Gateway identifier.",
+			},
+			tgXacConnectionId: schema.StringAttribute{
+				Required:    true,
+				Description: "The Transit Gateway Connection identifier.",
+			},
+			tgConnectionAction: schema.StringAttribute{
+				Required:    true,
+				Description: "The Transit Gateway Connection cross account action, `approve` or `reject`.",
+				Validators: []validator.String{
+					validate.StringOneOf(
+						transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions_Action_Approve,
+						transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions_Action_Reject),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the connection to leave the pending state, for example `10m` or `1h`. If not specified, defaults to `10m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after the approval or rejection is accepted without waiting for the connection. Default: false",
+			},
+		},
+	}
+}
+
+func (a *tgConnectionApprovalAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.TransitGatewayV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Transit Gateway Client",
+			"An unexpected error occurred when creating the Transit Gateway client.\n\n"+
+				"Transit Gateway Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *tgConnectionApprovalAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config tgConnectionApprovalActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gatewayId := config.Gateway.ValueString()
+	connectionId := config.ConnectionID.ValueString()
+	connectionAction := config.Action.ValueString()
+
+	// Parse timeout duration, default to 10 minutes
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %s action to connection '%s' of transit gateway '%s'...", connectionAction, connectionId, gatewayId),
+	})
+
+	createTransitGatewayConnectionActionsOptions := &transitgatewayapisv1.CreateTransitGatewayConnectionActionsOptions{}
+	createTransitGatewayConnectionActionsOptions.SetTransitGatewayID(gatewayId)
+	createTransitGatewayConnectionActionsOptions.SetID(connectionId)
+	createTransitGatewayConnectionActionsOptions.SetAction(connectionAction)
+	_, err := a.client.CreateTransitGatewayConnectionActionsWithContext(ctx, createTransitGatewayConnectionActionsOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Perform Transit Gateway Connection Action",
+			fmt.Sprintf("Failed to %s connection '%s' of transit gateway '%s': %s", connectionAction, connectionId, gatewayId, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Connection '%s' %s action submitted (no-wait mode)", connectionId, connectionAction),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for connection '%s' to leave the pending state (timeout: %v)...", connectionId, timeout),
+	})
+
+	connection, err := waitForTransitGatewayConnectionActionDone(ctx, a.client, gatewayId, connectionId, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Transit Gateway Connection Action Failed",
+			fmt.Sprintf("Failed waiting for connection '%s' of transit gateway '%s': %s", connectionId, gatewayId, err.Error()),
+		)
+		return
+	}
+
+	if tgConnection, ok := connection.(*transitgatewayapisv1.TransitGatewayConnectionCust); ok {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Connection '%s' %s action completed: request status %s, status %s", connectionId, connectionAction, *tgConnection.RequestStatus, *tgConnection.Status),
+		})
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Connection '%s' %s action completed: the connection was deleted", connectionId, connectionAction),
+	})
+}
+
+func waitForTransitGatewayConnectionActionDone(ctx context.Context, client *transitgatewayapisv1.TransitGatewayApisV1, gatewayId, connectionId string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for transit gateway connection (%s) to leave the pending state.", connectionId)
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{tgConnectionActionPending},
+		Target:  []string{tgConnectionActionDone, tgConnectionDeleted},
+		Refresh: func() (interface{}, string, error) {
+			getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+			getTransitGatewayConnectionOptions.SetTransitGatewayID(gatewayId)
+			getTransitGatewayConnectionOptions.SetID(connectionId)
+			connection, response, err := client.GetTransitGatewayConnectionWithContext(ctx, getTransitGatewayConnectionOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					// a rejected connection is deleted
+					return tgConnectionDeleted, tgConnectionDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error getting transit gateway connection: %s", err)
+			}
+			if *connection.RequestStatus == transitgatewayapisv1.TransitGatewayConnectionCust_RequestStatus_Pending {
+				return connection, tgConnectionActionPending, nil
+			}
+			switch *connection.Status {
+			case transitgatewayapisv1.TransitGatewayConnectionCust_Status_Pending,
+				transitgatewayapisv1.TransitGatewayConnectionCust_Status_NetworkPending:
+				return connection, tgConnectionActionPending, nil
+			case transitgatewayapisv1.TransitGatewayConnectionCust_Status_Failed:
+				return connection, *connection.Status, fmt.Errorf("transit gateway connection is in failed state")
+			}
+			return connection, tgConnectionActionDone, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package transitgateway_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMTransitGatewayConnectionActionInvokeApprove(t *testing.T) {
+	var randNum = acctest.RandIntRange(10, 100)
+	connectionName := fmt.Sprintf("tg-connection-name-%d", randNum)
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", randNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMTransitGatewayConnectionActionInvokeConfig(gatewayName, connectionName, "approve"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_tg_connection.test_tg_xac_connection", "name", connectionName),
+					testAccCheckIBMTransitGatewayConnectionActionRequestStatus("ibm_tg_connection.test_tg_xac_connection", "approved"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMTransitGatewayConnectionActionInvokeInvalidAction(t *testing.T) {
+	var randNum = acctest.RandIntRange(10, 100)
+	connectionName := fmt.Sprintf("tg-connection-name-%d", randNum)
+	gatewayName := fmt.Sprintf("tg-gateway-name-%d", randNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMTransitGatewayConnectionActionInvokeConfig(gatewayName, connectionName, "accept"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMTransitGatewayConnectionActionRequestStatus checks the request status of the connection after the action
+func testAccCheckIBMTransitGatewayConnectionActionRequestStatus(n, requestStatus string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := acc.TestAccProvider.Meta().(conns.ClientSession).TransitGatewayV1API()
+		if err != nil {
+			return err
+		}
+
+		getTransitGatewayConnectionOptions := &transitgatewayapisv1.GetTransitGatewayConnectionOptions{}
+		getTransitGatewayConnectionOptions.SetTransitGatewayID(rs.Primary.Attributes["gateway"])
+		getTransitGatewayConnectionOptions.SetID(rs.Primary.Attributes["connection_id"])
+		connection, _, err := client.GetTransitGatewayConnection(getTransitGatewayConnectionOptions)
+		if err != nil {
+			return fmt.Errorf("Error getting transit gateway connection: %s", err)
+		}
+
+		if *connection.RequestStatus != requestStatus {
+			return fmt.Errorf("Expected transit gateway connection request status %s, got %s", requestStatus, *connection.RequestStatus)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMTransitGatewayConnectionActionInvokeConfig(gatewayName, connectionName, connectionAction string) string {
+	return fmt.Sprintf(`
+resource "ibm_tg_gateway" "test_tg_gateway" {
+	name     = "%s"
+	location = "us-south"
+	global   = true
+}
+
+resource "ibm_tg_connection" "test_tg_xac_connection" {
+	gateway            = ibm_tg_gateway.test_tg_gateway.id
+	network_type       = "classic"
+	name               = "%s"
+	network_account_id = "%s"
+}
+
+action "ibm_tg_connection_action" "approve" {
+	provider = ibm.account2
+	config {
+		gateway       = ibm_tg_gateway.test_tg_gateway.id
+		connection_id = ibm_tg_connection.test_tg_xac_connection.connection_id
+		action        = "%s"
+	}
+}
+
+resource "null_resource" "trigger" {
+	lifecycle {
+		action_trigger {
+			events  = [after_create]
+			actions = [action.ibm_tg_connection_action.approve]
+		}
+	}
+	depends_on = [ibm_tg_connection.test_tg_xac_connection]
+}
+`, gatewayName, connectionName, acc.Tg_cross_network_account_id, connectionAction)
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var (
+	_ action.Action              = &isBareMetalServerPowerAction{}
+	_ action.ActionWithConfigure = &isBareMetalServerPowerAction{}
+)
+
+func NewIsBareMetalServerAction() action.Action {
+	return &isBareMetalServerPowerAction{}
+}
+
+type isBareMetalServerPowerAction struct {
+	client *vpcv1.VpcV1
+}
+
+type isBareMetalServerPowerActionModel struct {
+	BareMetalServer types.String `tfsdk:"bare_metal_server"`
+	Action          types.String `tfsdk:"action"`
+	StopType        types.String `tfsdk:"stop_type"`
+	Timeout         types.String `tfsdk:"timeout"`
+	NoWait          types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *isBareMetalServerPowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_is_bare_metal_server_action"
+}
+
+func (a *isBareMetalServerPowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, stops or restarts a VPC bare metal server on demand and waits for the bare metal server to reach the target status. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"bare_metal_server": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the bare metal server.",
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the action, one of `start`, `stop` or `restart`.",
+				Validators: []validator.String{
+					validate.StringOneOf("start", "restart", "stop"),
+				},
+			},
+			"stop_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The type of the stop operation, `soft` or `hard`. If not specified, defaults to `hard`. Only used when action is `stop`.",
+				Validators: []validator.String{
+					validate.StringOneOf("soft", "hard"),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the bare metal server to reach the target status, for example `10m` or `1h`. If not specified, defaults to `10m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after sending the request without waiting for the target status. Default: false",
+			},
+		},
+	}
+}
+
+func (a *isBareMetalServerPowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.VpcV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create VPC Client",
+			"An unexpected error occurred when creating the VPC client.\n\n"+
+				"VPC Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *isBareMetalServerPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config isBareMetalServerPowerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bareMetalServerID := config.BareMetalServer.ValueString()
+	actionType := config.Action.ValueString()
+
+	// Parse timeout duration, default to 10 minutes
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %s action to bare metal server '%s'...", actionType, bareMetalServerID),
+	})
+
+	var err error
+	switch actionType {
+	case "stop":
+		stopType := "hard"
+		if !config.StopType.IsNull() {
+			stopType = config.StopType.ValueString()
+		}
+		_, err = a.client.StopBareMetalServerWithContext(ctx, &vpcv1.StopBareMetalServerOptions{
+			ID:   &bareMetalServerID,
+			Type: &stopType,
+		})
+	case "start":
+		_, err = a.client.StartBareMetalServerWithContext(ctx, &vpcv1.StartBareMetalServerOptions{
+			ID: &bareMetalServerID,
+		})
+	case "restart":
+		_, err = a.client.RestartBareMetalServerWithContext(ctx, &vpcv1.RestartBareMetalServerOptions{
+			ID: &bareMetalServerID,
+		})
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Bare Metal Server Action",
+			fmt.Sprintf("Failed to %s bare metal server '%s': %s", actionType, bareMetalServerID, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Bare metal server '%s' %s action submitted (no-wait mode)", bareMetalServerID, actionType),
+		})
+		return
+	}
+
+	target := isBareMetalServerStatusRunning
+	if actionType == "stop" {
+		target = isBareMetalServerActionStatusStopped
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for bare metal server '%s' to be %s (timeout: %v)...", bareMetalServerID, target, timeout),
+	})
+
+	_, err = waitForIsBareMetalServerActionStatus(ctx, a.client, bareMetalServerID, target, timeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Bare Metal Server Action Failed",
+			fmt.Sprintf("Failed waiting for bare metal server '%s' to be %s: %s", bareMetalServerID, target, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Bare metal server '%s' %s action completed successfully", bareMetalServerID, actionType),
+	})
+}
+
+func waitForIsBareMetalServerActionStatus(ctx context.Context, client *vpcv1.VpcV1, bareMetalServerID, target string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) (interface{}, error) {
+	log.Printf("Waiting for bare metal server (%s) to be %s.", bareMetalServerID, target)
+	lastStatus := ""
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{isBareMetalServerStatusRunning, isBareMetalServerActionStatusStopped, isBareMetalServerStatusPending,
+			isBareMetalServerActionStatusStopping, isBareMetalServerActionStatusStarting, isBareMetalServerStatusRestarting},
+		Target: []string{target},
+		Refresh: func() (interface{}, string, error) {
+			bms, _, err := client.GetBareMetalServerWithContext(ctx, &vpcv1.GetBareMetalServerOptions{
+				ID: &bareMetalServerID,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("error getting bare metal server: %s", err)
+			}
+			if *bms.Status != lastStatus {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Bare metal server status: %s", *bms.Status),
+				})
+				lastStatus = *bms.Status
+			}
+			if *bms.Status == isBareMetalServerStatusFailed {
+				return bms, *bms.Status, fmt.Errorf("bare metal server is in failed state")
+			}
+			return bms, *bms.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var (
+	_ action.Action              = &isInstancePowerAction{}
+	_ action.ActionWithConfigure = &isInstancePowerAction{}
+)
+
+func NewIsInstanceAction() action.Action {
+	return &isInstancePowerAction{}
+}
+
+type isInstancePowerAction struct {
+	client *vpcv1.VpcV1
+}
+
+type isInstancePowerActionModel struct {
+	Instance    types.String `tfsdk:"instance"`
+	Action      types.String `tfsdk:"action"`
+	ForceAction types.Bool   `tfsdk:"force_action"`
+	Timeout     types.String `tfsdk:"timeout"`
+	NoWait      types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *isInstancePowerAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_is_instance_action"
+}
+
+func (a *isInstancePowerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, stops or reboots a VPC virtual server instance on demand and waits for the instance to reach the target status. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the instance.",
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the action, one of `start`, `stop` or `reboot`. A stop or reboot requires the instance to be running, a start requires it to be stopped.",
+				Validators: []validator.String{
+					validate.StringOneOf("start", "reboot", "stop"),
+				},
+			},
+			"force_action": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action is forced immediately, and all queued actions of the instance are deleted. Default: false",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait for the instance to reach the target status, for example `10m` or `1h`. If not specified, defaults to `10m`. Ignored when no_wait is true.",
+				Validators: []validator.String{
+					validate.ValidDuration(),
+				},
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after creating the instance action without waiting for the target status. Default: false",
+			},
+		},
+	}
+}
+
+func (a *isInstancePowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.VpcV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create VPC Client",
+			"An unexpected error occurred when creating the VPC client.\n\n"+
+				"VPC Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *isInstancePowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config isInstancePowerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.Instance.ValueString()
+	actionType := config.Action.ValueString()
+
+	// Parse timeout duration, default to 10 minutes
+	timeout := 10 * time.Minute
+	if !config.Timeout.IsNull() {
+		timeout, _ = time.ParseDuration(config.Timeout.ValueString())
+	}
+
+	instance, _, err := a.client.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Get Instance",
+			fmt.Sprintf("Failed to get instance '%s': %s", instanceID, err.Error()),
+		)
+		return
+	}
+	if (actionType == "stop" || actionType == "reboot") && *instance.Status != isInstanceStatusRunning {
+		resp.Diagnostics.AddError(
+			"Invalid Instance Status",
+			fmt.Sprintf("Cannot %s instance '%s' while it is %s, the instance must be %s", actionType, instanceID, *instance.Status, isInstanceStatusRunning),
+		)
+		return
+	} else if actionType == "start" && *instance.Status != isInstanceActionStatusStopped {
+		resp.Diagnostics.AddError(
+			"Invalid Instance Status",
+			fmt.Sprintf("Cannot start instance '%s' while it is %s, the instance must be %s", instanceID, *instance.Status, isInstanceActionStatusStopped),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending %s action to instance '%s'...", actionType, instanceID),
+	})
+
+	createInstanceActionOptions := &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &instanceID,
+		Type:       &actionType,
+	}
+	if !config.ForceAction.IsNull() {
+		createInstanceActionOptions.Force = config.ForceAction.ValueBoolPointer()
+	}
+	_, _, err = a.client.CreateInstanceActionWithContext(ctx, createInstanceActionOptions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Instance Action",
+			fmt.Sprintf("Failed to %s instance '%s': %s", actionType, instanceID, err.Error()),
+		)
+		return
+	}
+
+	// Return immediately if no_wait set to true
+	if config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Instance '%s' %s action submitted (no-wait mode)", instanceID, actionType),
+		})
+		return
+	}
+
+	target := isInstanceStatusRunning
+	if actionType == "stop" {
+		target = isInstanceActionStatusStopped
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for instance '%s' to be %s (timeout: %v)...", instanceID, target, timeout),
+	})
+
+	_, err = waitForIsInstanceActionStatus(ctx, a.client, instanceID, target, timeout, resp.SendProgress)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Instance Action Failed",
+			fmt.Sprintf("Failed waiting for instance '%s' to be %s: %s", instanceID, target, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance '%s' %s action completed successfully", instanceID, actionType),
+	})
+}
+
+func waitForIsInstanceActionStatus(ctx context.Context, client *vpcv1.VpcV1, instanceID, target string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) (interface{}, error) {
+	log.Printf("Waiting for instance (%s) to be %s.", instanceID, target)
+	lastStatus := ""
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{isInstanceStatusRunning, isInstanceActionStatusStopped, isInstanceStatusPending,
+			isInstanceActionStatusStopping, isInstanceStatusStarting, isInstanceStatusRestarting},
+		Target: []string{target},
+		Refresh: func() (interface{}, string, error) {
+			instance, _, err := client.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{
+				ID: &instanceID,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("error getting instance: %s", err)
+			}
+			if *instance.Status != lastStatus {
+				sendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Instance status: %s", *instance.Status),
+				})
+				lastStatus = *instance.Status
+			}
+			if *instance.Status == isInstanceStatusFailed {
+				return instance, *instance.Status, fmt.Errorf("instance is in failed state")
+			}
+			return instance, *instance.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -602,9 +602,9 @@ resource "ibm_is_instance" "testacc_instance" {
   availability {
     class = "spot"
   }
-  availability_policy_host_failure = "restart"
   availability_policy {
-    preemption = "stop"
+    host_failure = "restart"
+    preemption   = "stop"
   }
   primary_network_interface {
     subnet     = ibm_is_subnet.testacc_subnet.id
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isFloatingIPListResource{}
+	_ list.ListResourceWithConfigure    = &isFloatingIPListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isFloatingIPListResource{}
+)
+
+func NewIsFloatingIPListResource() list.ListResource {
+	return &isFloatingIPListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_floating_ip",
+			newResource: ResourceIBMISFloatingIP,
+		},
+	}
+}
+
+type isFloatingIPListResource struct {
+	vpcListResource
+}
+
+func (r *isFloatingIPListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the floating IPs in the region of the provider.", nil)
+}
+
+func (r *isFloatingIPListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data vpcListFilterModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data, types.StringNull())
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listFloatingIpsPage)
+}
+
+func listFloatingIpsPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListFloatingIpsOptions{}
+	if filters.ResourceGroupID != "" {
+		options.SetResourceGroupID(filters.ResourceGroupID)
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListFloatingIpsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListFloatingIpsWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.FloatingIps))
+	for _, floatingIP := range collection.FloatingIps {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(floatingIP.ID),
+			Name:            flex.StringValue(floatingIP.Name),
+			CRN:             flex.StringValue(floatingIP.CRN),
+			ResourceGroupID: resourceGroupID(floatingIP.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
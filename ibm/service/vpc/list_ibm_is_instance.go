@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isInstanceListResource{}
+	_ list.ListResourceWithConfigure    = &isInstanceListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isInstanceListResource{}
+)
+
+func NewIsInstanceListResource() list.ListResource {
+	return &isInstanceListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_instance",
+			newResource: ResourceIBMISInstance,
+		},
+	}
+}
+
+type isInstanceListResource struct {
+	vpcListResource
+}
+
+type isInstanceListModel struct {
+	vpcListFilterModel
+	VPC types.String `tfsdk:"vpc"`
+}
+
+func (r *isInstanceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the virtual server instances in the region of the provider.", map[string]listschema.Attribute{
+		"vpc": vpcListVPCAttribute(),
+	})
+}
+
+func (r *isInstanceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data isInstanceListModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data.vpcListFilterModel, data.VPC)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listInstancesPage)
+}
+
+func listInstancesPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListInstancesOptions{}
+	if filters.ResourceGroupID != "" {
+		options.SetResourceGroupID(filters.ResourceGroupID)
+	}
+	if filters.VPCID != "" {
+		options.SetVPCID(filters.VPCID)
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListInstancesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListInstancesWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.Instances))
+	for _, instance := range collection.Instances {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(instance.ID),
+			Name:            flex.StringValue(instance.Name),
+			CRN:             flex.StringValue(instance.CRN),
+			ResourceGroupID: resourceGroupID(instance.ResourceGroup),
+			VPCID:           vpcReferenceID(instance.VPC),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isLBListResource{}
+	_ list.ListResourceWithConfigure    = &isLBListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isLBListResource{}
+)
+
+func NewIsLBListResource() list.ListResource {
+	return &isLBListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_lb",
+			newResource: ResourceIBMISLB,
+		},
+	}
+}
+
+type isLBListResource struct {
+	vpcListResource
+}
+
+func (r *isLBListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the load balancers in the region of the provider.", nil)
+}
+
+func (r *isLBListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data vpcListFilterModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data, types.StringNull())
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listLoadBalancersPage)
+}
+
+func listLoadBalancersPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListLoadBalancersOptions{}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListLoadBalancersWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListLoadBalancersWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.LoadBalancers))
+	for _, lb := range collection.LoadBalancers {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(lb.ID),
+			Name:            flex.StringValue(lb.Name),
+			CRN:             flex.StringValue(lb.CRN),
+			ResourceGroupID: resourceGroupID(lb.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
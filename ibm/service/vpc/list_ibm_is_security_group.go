@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isSecurityGroupListResource{}
+	_ list.ListResourceWithConfigure    = &isSecurityGroupListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isSecurityGroupListResource{}
+)
+
+func NewIsSecurityGroupListResource() list.ListResource {
+	return &isSecurityGroupListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_security_group",
+			newResource: ResourceIBMISSecurityGroup,
+		},
+	}
+}
+
+type isSecurityGroupListResource struct {
+	vpcListResource
+}
+
+type isSecurityGroupListModel struct {
+	vpcListFilterModel
+	VPC types.String `tfsdk:"vpc"`
+}
+
+func (r *isSecurityGroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the security groups in the region of the provider.", map[string]listschema.Attribute{
+		"vpc": vpcListVPCAttribute(),
+	})
+}
+
+func (r *isSecurityGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data isSecurityGroupListModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data.vpcListFilterModel, data.VPC)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listSecurityGroupsPage)
+}
+
+func listSecurityGroupsPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListSecurityGroupsOptions{}
+	if filters.ResourceGroupID != "" {
+		options.SetResourceGroupID(filters.ResourceGroupID)
+	}
+	if filters.VPCID != "" {
+		options.SetVPCID(filters.VPCID)
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListSecurityGroupsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListSecurityGroupsWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.SecurityGroups))
+	for _, securityGroup := range collection.SecurityGroups {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(securityGroup.ID),
+			Name:            flex.StringValue(securityGroup.Name),
+			CRN:             flex.StringValue(securityGroup.CRN),
+			ResourceGroupID: resourceGroupID(securityGroup.ResourceGroup),
+			VPCID:           vpcReferenceID(securityGroup.VPC),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isSubnetListResource{}
+	_ list.ListResourceWithConfigure    = &isSubnetListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isSubnetListResource{}
+)
+
+func NewIsSubnetListResource() list.ListResource {
+	return &isSubnetListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_subnet",
+			newResource: ResourceIBMISSubnet,
+		},
+	}
+}
+
+type isSubnetListResource struct {
+	vpcListResource
+}
+
+type isSubnetListModel struct {
+	vpcListFilterModel
+	VPC types.String `tfsdk:"vpc"`
+}
+
+func (r *isSubnetListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the subnets in the region of the provider.", map[string]listschema.Attribute{
+		"vpc": vpcListVPCAttribute(),
+	})
+}
+
+func (r *isSubnetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data isSubnetListModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data.vpcListFilterModel, data.VPC)
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listSubnetsPage)
+}
+
+func listSubnetsPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListSubnetsOptions{}
+	if filters.ResourceGroupID != "" {
+		options.SetResourceGroupID(filters.ResourceGroupID)
+	}
+	if filters.VPCID != "" {
+		options.SetVPCID(filters.VPCID)
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListSubnetsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListSubnetsWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.Subnets))
+	for _, subnet := range collection.Subnets {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(subnet.ID),
+			Name:            flex.StringValue(subnet.Name),
+			CRN:             flex.StringValue(subnet.CRN),
+			ResourceGroupID: resourceGroupID(subnet.ResourceGroup),
+			VPCID:           vpcReferenceID(subnet.VPC),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isVolumeListResource{}
+	_ list.ListResourceWithConfigure    = &isVolumeListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isVolumeListResource{}
+)
+
+func NewIsVolumeListResource() list.ListResource {
+	return &isVolumeListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_volume",
+			newResource: ResourceIBMISVolume,
+		},
+	}
+}
+
+type isVolumeListResource struct {
+	vpcListResource
+}
+
+func (r *isVolumeListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the block storage volumes in the region of the provider.", nil)
+}
+
+func (r *isVolumeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data vpcListFilterModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data, types.StringNull())
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listVolumesPage)
+}
+
+func listVolumesPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListVolumesOptions{}
+	if len(filters.Tags) > 0 {
+		options.SetTag(filters.Tags[0])
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListVolumesWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListVolumesWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.Volumes))
+	for _, volume := range collection.Volumes {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(volume.ID),
+			Name:            flex.StringValue(volume.Name),
+			CRN:             flex.StringValue(volume.CRN),
+			ResourceGroupID: resourceGroupID(volume.ResourceGroup),
+			UserTags:        append([]string{}, volume.UserTags...),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
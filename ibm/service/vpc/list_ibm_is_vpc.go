@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource                 = &isVPCListResource{}
+	_ list.ListResourceWithConfigure    = &isVPCListResource{}
+	_ list.ListResourceWithRawV5Schemas = &isVPCListResource{}
+)
+
+func NewIsVPCListResource() list.ListResource {
+	return &isVPCListResource{
+		vpcListResource: vpcListResource{
+			typeName:    "ibm_is_vpc",
+			newResource: ResourceIBMISVPC,
+		},
+	}
+}
+
+type isVPCListResource struct {
+	vpcListResource
+}
+
+func (r *isVPCListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = vpcListSchema("Lists the VPCs in the region of the provider.", nil)
+}
+
+func (r *isVPCListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data vpcListFilterModel
+	diags := req.Config.Get(ctx, &data)
+	filters, filterDiags := vpcListFiltersFrom(ctx, data, types.StringNull())
+	diags.Append(filterDiags...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	r.list(ctx, req, stream, filters, listVpcsPage)
+}
+
+func listVpcsPage(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error) {
+	options := &vpcv1.ListVpcsOptions{}
+	if filters.ResourceGroupID != "" {
+		options.SetResourceGroupID(filters.ResourceGroupID)
+	}
+	if start != "" {
+		options.SetStart(start)
+	}
+
+	collection, response, err := sess.ListVpcsWithContext(ctx, options)
+	if err != nil {
+		return nil, "", fmt.Errorf("ListVpcsWithContext failed: %s\n%s", err, response)
+	}
+
+	items := make([]vpcListItem, 0, len(collection.Vpcs))
+	for _, vpc := range collection.Vpcs {
+		items = append(items, vpcListItem{
+			ID:              flex.StringValue(vpc.ID),
+			Name:            flex.StringValue(vpc.Name),
+			CRN:             flex.StringValue(vpc.CRN),
+			ResourceGroupID: resourceGroupID(vpc.ResourceGroup),
+		})
+	}
+	return items, flex.GetNext(collection.Next), nil
+}
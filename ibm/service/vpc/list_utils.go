@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// vpcListResource holds the behavior shared by the list resources of the VPC resources, which are
+// implemented with SDKv2. Each listed item is turned into the identity and, when requested, the full
+// state of the SDKv2 resource by reading it with the SDKv2 read function.
+type vpcListResource struct {
+	typeName    string
+	newResource func() *schema.Resource
+	session     conns.ClientSession
+}
+
+// vpcListItem is a VPC resource returned by a list API.
+type vpcListItem struct {
+	ID              string
+	Name            string
+	CRN             string
+	ResourceGroupID string
+	VPCID           string
+
+	// UserTags are the user tags of the item, when the list API returns them.
+	UserTags []string
+}
+
+// vpcListFilterModel holds the filters supported by all the VPC list resources.
+type vpcListFilterModel struct {
+	ResourceGroup types.String `tfsdk:"resource_group"`
+	Tags          types.List   `tfsdk:"tags"`
+}
+
+// vpcListFilters are the filters applied to the listed items.
+type vpcListFilters struct {
+	ResourceGroupID string
+	VPCID           string
+	Tags            []string
+}
+
+// vpcListPageFunc returns the items of the page that starts at start, and the start of the next page.
+type vpcListPageFunc func(ctx context.Context, sess *vpcv1.VpcV1, filters vpcListFilters, start string) ([]vpcListItem, string, error)
+
+// vpcListSchema returns the schema of a list resource, composed of the common filters and the given attributes.
+func vpcListSchema(description string, attributes map[string]listschema.Attribute) listschema.Schema {
+	listAttributes := map[string]listschema.Attribute{
+		"resource_group": listschema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the resource group to list the resources from.",
+		},
+		"tags": listschema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "List only the resources that have all of these user tags.",
+		},
+	}
+	for name, attribute := range attributes {
+		listAttributes[name] = attribute
+	}
+	return listschema.Schema{
+		Description: description,
+		Attributes:  listAttributes,
+	}
+}
+
+// vpcListVPCAttribute returns the attribute that filters the listed resources by VPC.
+func vpcListVPCAttribute() listschema.Attribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "The ID of the VPC to list the resources from.",
+	}
+}
+
+func (r *vpcListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r *vpcListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.session = session
+}
+
+// sdkResource returns the SDKv2 resource being listed, with its identity and the attributes added by the provider.
+func (r *vpcListResource) sdkResource() *schema.Resource {
+	return flex.WithDeletionProtection(flex.WithDefaultTags(WithResourceIdentity(r.typeName, r.newResource())))
+}
+
+func (r *vpcListResource) RawV5Schemas(ctx context.Context, req list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	sdkResource := r.sdkResource()
+	resp.ProtoV5Schema = sdkResource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = sdkResource.ProtoIdentitySchema(ctx)()
+}
+
+// list streams the items returned by the page function that match the filters.
+func (r *vpcListResource) list(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, filters vpcListFilters, listPage vpcListPageFunc) {
+	if r.session == nil {
+		stream.Results = list.ListResultsStreamDiagnostics(fwdiag.Diagnostics{fwdiag.NewErrorDiagnostic(
+			"Unconfigured Provider",
+			"The provider has not been configured. Please report this issue to the provider developers.",
+		)})
+		return
+	}
+	sess, err := vpcClient(r.session)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(List) "+r.typeName, "list")
+		stream.Results = list.ListResultsStreamDiagnostics(fwdiag.Diagnostics{fwdiag.NewErrorDiagnostic(tfErr.Error(), tfErr.GetConsoleMessage())})
+		return
+	}
+
+	sdkResource := r.sdkResource()
+	identity := resourceIdentities[r.typeName]
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var taggedCRNs map[string]bool
+		count := int64(0)
+		start := ""
+		for {
+			items, next, err := listPage(ctx, sess, filters, start)
+			if err != nil {
+				push(r.errorResult(err, "list"))
+				return
+			}
+
+			for _, item := range items {
+				if !filters.match(item) {
+					continue
+				}
+				if len(filters.Tags) > 0 && item.UserTags == nil {
+					if taggedCRNs == nil {
+						taggedCRNs, err = r.taggedCRNs(filters.Tags)
+						if err != nil {
+							push(r.errorResult(err, "list"))
+							return
+						}
+					}
+					if !taggedCRNs[item.CRN] {
+						continue
+					}
+				}
+
+				result, found := r.listResult(ctx, req, sdkResource, identity, item)
+				if !found {
+					continue
+				}
+				if !push(result) {
+					return
+				}
+				count++
+				if req.Limit > 0 && count >= req.Limit {
+					return
+				}
+			}
+
+			start = next
+			if start == "" {
+				return
+			}
+		}
+	}
+}
+
+// listResult builds the result of a listed item. It returns false when the item disappeared before it could be read.
+func (r *vpcListResource) listResult(ctx context.Context, req list.ListRequest, sdkResource *schema.Resource, identity flex.CompositeIdentity, item vpcListItem) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.Name
+
+	d := sdkResource.Data(&terraform.InstanceState{})
+	d.SetId(item.ID)
+
+	if req.IncludeResource {
+		diags := sdkResource.ReadContext(ctx, d, r.session)
+		for _, diagnostic := range diags {
+			if diagnostic.Severity == diag.Error {
+				result.Diagnostics.AddError(diagnostic.Summary, diagnostic.Detail)
+			} else {
+				result.Diagnostics.AddWarning(diagnostic.Summary, diagnostic.Detail)
+			}
+		}
+		if diags.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+	} else if err := identity.SetIdentity(d, r.session); err != nil {
+		return r.errorResult(err, "read"), true
+	}
+
+	identityValue, err := d.TfTypeIdentityState()
+	if err != nil {
+		return r.errorResult(err, "read"), true
+	}
+	result.Identity.Raw = *identityValue
+
+	if req.IncludeResource {
+		resourceValue, err := d.TfTypeResourceState()
+		if err != nil {
+			return r.errorResult(err, "read"), true
+		}
+		result.Resource.Raw = *resourceValue
+	}
+	return result, true
+}
+
+func (r *vpcListResource) errorResult(err error, operation string) list.ListResult {
+	tfErr := flex.TerraformErrorf(err, err.Error(), "(List) "+r.typeName, operation)
+	return list.ListResult{
+		Diagnostics: fwdiag.Diagnostics{fwdiag.NewErrorDiagnostic(tfErr.Error(), tfErr.GetConsoleMessage())},
+	}
+}
+
+// taggedCRNs returns the CRNs of the VPC resources that have all the given user tags, using the global search API.
+func (r *vpcListResource) taggedCRNs(tags []string) (map[string]bool, error) {
+	gsClient, err := r.session.GlobalSearchAPIV2()
+	if err != nil {
+		return nil, fmt.Errorf("Error getting global search client settings: %s", err)
+	}
+
+	query := []string{"service_name:is"}
+	for _, tag := range tags {
+		query = append(query, fmt.Sprintf("tags:%q", tag))
+	}
+	options := &globalsearchv2.SearchOptions{}
+	options.SetQuery(strings.Join(query, " AND "))
+	options.SetFields([]string{"crn"})
+	options.SetLimit(1000)
+
+	crns := map[string]bool{}
+	for {
+		result, response, err := gsClient.Search(options)
+		if err != nil {
+			return nil, fmt.Errorf("Error searching the resources with tags %v: %s\n%s", tags, err, response)
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil {
+				crns[*item.CRN] = true
+			}
+		}
+		if result.SearchCursor == nil || len(result.Items) == 0 {
+			return crns, nil
+		}
+		options.SetSearchCursor(*result.SearchCursor)
+	}
+}
+
+// vpcListFiltersFrom reads the common filters of the list resource configuration.
+func vpcListFiltersFrom(ctx context.Context, model vpcListFilterModel, vpc types.String) (vpcListFilters, fwdiag.Diagnostics) {
+	filters := vpcListFilters{
+		ResourceGroupID: model.ResourceGroup.ValueString(),
+		VPCID:           vpc.ValueString(),
+	}
+	var diags fwdiag.Diagnostics
+	if !model.Tags.IsNull() && !model.Tags.IsUnknown() {
+		diags.Append(model.Tags.ElementsAs(ctx, &filters.Tags, false)...)
+	}
+	return filters, diags
+}
+
+// match reports whether the item matches the resource group, VPC and user tags filters. Items that
+// do not report their user tags are matched against the tags filter separately.
+func (filters vpcListFilters) match(item vpcListItem) bool {
+	if filters.ResourceGroupID != "" && item.ResourceGroupID != filters.ResourceGroupID {
+		return false
+	}
+	if filters.VPCID != "" && item.VPCID != filters.VPCID {
+		return false
+	}
+	if len(filters.Tags) > 0 && item.UserTags != nil {
+		for _, tag := range filters.Tags {
+			if !flex.StringContains(item.UserTags, tag) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func resourceGroupID(ref *vpcv1.ResourceGroupReference) string {
+	if ref == nil || ref.ID == nil {
+		return ""
+	}
+	return *ref.ID
+}
+
+func vpcReferenceID(ref *vpcv1.VPCReference) string {
+	if ref == nil || ref.ID == nil {
+		return ""
+	}
+	return *ref.ID
+}
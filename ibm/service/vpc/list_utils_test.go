@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPCListFiltersMatch(t *testing.T) {
+	item := vpcListItem{
+		ID:              "r006-instance",
+		ResourceGroupID: "group1",
+		VPCID:           "r006-vpc",
+		UserTags:        []string{"env:production", "team:network"},
+	}
+
+	assert.True(t, vpcListFilters{}.match(item))
+	assert.True(t, vpcListFilters{ResourceGroupID: "group1", VPCID: "r006-vpc"}.match(item))
+	assert.True(t, vpcListFilters{Tags: []string{"env:production", "team:network"}}.match(item))
+	assert.False(t, vpcListFilters{ResourceGroupID: "group2"}.match(item))
+	assert.False(t, vpcListFilters{VPCID: "r006-other"}.match(item))
+	assert.False(t, vpcListFilters{Tags: []string{"env:production", "team:storage"}}.match(item))
+}
+
+func TestVPCListFiltersMatchWithoutUserTags(t *testing.T) {
+	// Items whose list API does not return user tags are filtered by tag separately
+	item := vpcListItem{ID: "r006-vpc", ResourceGroupID: "group1"}
+
+	assert.True(t, vpcListFilters{Tags: []string{"env:production"}}.match(item))
+}
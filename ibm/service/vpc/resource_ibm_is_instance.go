@@ -134,7 +134,7 @@ const (
 )
 
 func ResourceIBMISInstance() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		CreateContext: resourceIBMisInstanceCreate,
 		ReadContext:   resourceIBMisInstanceRead,
 		UpdateContext: resourceIBMisInstanceUpdate,
@@ -179,6 +179,8 @@ func ResourceIBMISInstance() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			customdiff.Sequence(
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -191,14 +193,6 @@ func ResourceIBMISInstance() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
-			isInstanceAvailablePolicyHostFailure: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Deprecated:  "Use availability_policy.0.host_failure instead. Existing configurations can continue using this attribute, switching attributes with the same value will not trigger change.",
-				Computed:    true,
-				Description: "The availability policy to use for this virtual server instance",
-			},
-
 			// spot changes
 			"availability": &schema.Schema{
 				Type:     schema.TypeList,
@@ -702,14 +696,6 @@ func ResourceIBMISInstance() *schema.Resource {
 							DiffSuppressFunc: flex.ApplyOnce,
 							Deprecated:       "This field is deprected",
 						},
-						isInstanceNicPrimaryIpv4Address: {
-							Type:          schema.TypeString,
-							ForceNew:      true,
-							Optional:      true,
-							Computed:      true,
-							ConflictsWith: []string{"primary_network_interface.0.primary_ip.0.address"},
-							Deprecated:    "primary_ipv4_address is deprecated and support will be removed. Use primary_ip instead",
-						},
 						isInstanceNicPrimaryIP: {
 							Type:        schema.TypeList,
 							MinItems:    0,
@@ -720,12 +706,11 @@ func ResourceIBMISInstance() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									isInstanceNicReservedIpAddress: {
-										Type:          schema.TypeString,
-										Computed:      true,
-										ForceNew:      true,
-										Optional:      true,
-										ConflictsWith: []string{"primary_network_interface.0.primary_ipv4_address"},
-										Description:   "The IP address to reserve, which must not already be reserved on the subnet.",
+										Type:        schema.TypeString,
+										Computed:    true,
+										ForceNew:    true,
+										Optional:    true,
+										Description: "The IP address to reserve, which must not already be reserved on the subnet.",
 									},
 									isInstanceNicReservedIpHref: {
 										Type:        schema.TypeString,
@@ -748,7 +733,7 @@ func ResourceIBMISInstance() *schema.Resource {
 										Type:          schema.TypeString,
 										Optional:      true,
 										ForceNew:      true,
-										ConflictsWith: []string{"primary_network_interface.0.primary_ipv4_address", "primary_network_interface.0.primary_ip.0.address"},
+										ConflictsWith: []string{"primary_network_interface.0.primary_ip.0.address"},
 										Computed:      true,
 										Description:   "Identifies a reserved IP by a unique property.",
 									},
@@ -1279,13 +1264,6 @@ func ResourceIBMISInstance() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
-						isInstanceNicPrimaryIpv4Address: {
-							Type:       schema.TypeString,
-							ForceNew:   true,
-							Optional:   true,
-							Deprecated: "primary_ipv4_address is deprecated and support will be removed. Use primary_ip instead",
-							Computed:   true,
-						},
 						isInstanceNicPrimaryIP: {
 							Type:        schema.TypeList,
 							MinItems:    0,
@@ -1908,23 +1886,13 @@ func ResourceIBMISInstance() *schema.Resource {
 					},
 				},
 			},
-			isInstanceMetadataServiceEnabled: {
-				Type:          schema.TypeBool,
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{isInstanceMetadataService},
-				Deprecated:    "Use metadata_service instead",
-				Description:   "Indicates whether the metadata service endpoint is available to the virtual server instance",
-			},
-
 			isInstanceMetadataService: {
-				Type:          schema.TypeList,
-				Optional:      true,
-				Computed:      true,
-				MinItems:      1,
-				MaxItems:      1,
-				ConflictsWith: []string{isInstanceMetadataServiceEnabled},
-				Description:   "The metadata service configuration",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The metadata service configuration",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						isInstanceMetadataServiceEnabled1: {
@@ -2223,6 +2191,31 @@ func ResourceIBMISInstance() *schema.Resource {
 			},
 		},
 	}
+	resource.StateUpgraders = []schema.StateUpgrader{
+		resourceIBMISInstanceStateUpgraderV0(resource.Schema),
+	}
+	return resource
+}
+
+// resourceIBMISInstanceStateUpgraderV0 upgrades the state of schema version 0, which moves the values
+// of the retired attributes availability_policy_host_failure, metadata_service_enabled and
+// primary_ipv4_address of the network interfaces to the attributes that replaced them
+func resourceIBMISInstanceStateUpgraderV0(current map[string]*schema.Schema) schema.StateUpgrader {
+	retired := func(t schema.ValueType) *schema.Schema {
+		return &schema.Schema{Type: t, Optional: true, Computed: true}
+	}
+	prior := flex.PriorSchema(current, map[string]*schema.Schema{
+		isInstanceAvailablePolicyHostFailure:                                      retired(schema.TypeString),
+		isInstanceMetadataServiceEnabled:                                          retired(schema.TypeBool),
+		isInstancePrimaryNetworkInterface + "." + isInstanceNicPrimaryIpv4Address: retired(schema.TypeString),
+		isInstanceNetworkInterfaces + "." + isInstanceNicPrimaryIpv4Address:       retired(schema.TypeString),
+	})
+	return flex.NewStateUpgrader(0, prior,
+		flex.StateAttributeToBlock(isInstanceAvailablePolicyHostFailure, "availability_policy", "host_failure"),
+		flex.StateAttributeToBlock(isInstanceMetadataServiceEnabled, isInstanceMetadataService, isInstanceMetadataServiceEnabled1),
+		flex.StateAttributeToBlock(isInstancePrimaryNetworkInterface+"."+isInstanceNicPrimaryIpv4Address, isInstanceNicPrimaryIP, isInstanceNicReservedIpAddress),
+		flex.StateAttributeToBlock(isInstanceNetworkInterfaces+"."+isInstanceNicPrimaryIpv4Address, isInstanceNicPrimaryIP, isInstanceNicReservedIpAddress),
+	)
 }
 
 func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
@@ -2377,13 +2370,6 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 		}
 		instanceproto.AvailabilityPolicy = AvailabilityPolicyModel
 	}
-	//deprecated
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
 	// shared core
 	if vcpuOk, ok := d.GetOk("vcpu"); ok && len(vcpuOk.([]interface{})) > 0 {
 		VcpuModel, err := ResourceIBMIsInstanceMapToInstanceVcpuPrototype(vcpuOk.([]interface{})[0].(map[string]interface{}))
@@ -2690,10 +2676,8 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 
 		// reserved ip changes
 
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
+		var reservedIp, reservedipv4, reservedipname string
 		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
 
 		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
 		if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -2711,13 +2695,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 			autodelete = reservedipautodeleteok.(bool)
 		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			err = fmt.Errorf("Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 			err = fmt.Errorf("Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2728,11 +2706,8 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 				ID: &reservedIp,
 			}
 		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			if reservedipv4 != "" || reservedipname != "" || okAuto {
 				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
 				if reservedipv4 != "" {
 					primaryipobj.Address = &reservedipv4
 				}
@@ -2787,10 +2762,8 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 
 			// reserved ip changes
 
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
+			var reservedIp, reservedipv4, reservedipname string
 			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
 
 			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
 			if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -2808,13 +2781,7 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 				autodelete = reservedipautodeleteok.(bool)
 			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				err = fmt.Errorf("Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+			if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 				err = fmt.Errorf("Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -2825,11 +2792,8 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 					ID: &reservedIp,
 				}
 			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+				if reservedipv4 != "" || reservedipname != "" || okAuto {
 					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
 					if reservedipv4 != "" {
 						primaryipobj.Address = &reservedipv4
 					}
@@ -2893,13 +2857,6 @@ func instanceCreateByImage(context context.Context, d *schema.ResourceData, meta
 
 	}
 
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
@@ -2979,13 +2936,6 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 		}
 		instanceproto.AvailabilityPolicy = AvailabilityPolicyModel
 	}
-	// deprecated
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
 	// shared core
 	if vcpuOk, ok := d.GetOk("vcpu"); ok && len(vcpuOk.([]interface{})) > 0 {
 		VcpuModel, err := ResourceIBMIsInstanceMapToInstanceVcpuPrototype(vcpuOk.([]interface{})[0].(map[string]interface{}))
@@ -3316,10 +3266,8 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 
 		// reserved ip changes
 
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
+		var reservedIp, reservedipv4, reservedipname string
 		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
 
 		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
 		if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -3337,13 +3285,7 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 			autodelete = reservedipautodeleteok.(bool)
 		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			err = fmt.Errorf("Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 			err = fmt.Errorf("Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3354,11 +3296,8 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 				ID: &reservedIp,
 			}
 		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			if reservedipv4 != "" || reservedipname != "" || okAuto {
 				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
 				if reservedipv4 != "" {
 					primaryipobj.Address = &reservedipv4
 				}
@@ -3413,10 +3352,8 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 
 			// reserved ip changes
 
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
+			var reservedIp, reservedipv4, reservedipname string
 			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
 
 			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
 			if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -3434,13 +3371,7 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 				autodelete = reservedipautodeleteok.(bool)
 			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				err = fmt.Errorf("Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+			if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 				err = fmt.Errorf("Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3451,11 +3382,8 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 					ID: &reservedIp,
 				}
 			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+				if reservedipv4 != "" || reservedipname != "" || okAuto {
 					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
 					if reservedipv4 != "" {
 						primaryipobj.Address = &reservedipv4
 					}
@@ -3519,13 +3447,6 @@ func instanceCreateByCatalogOffering(context context.Context, d *schema.Resource
 
 	}
 
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
@@ -3592,13 +3513,6 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 		}
 		instanceproto.AvailabilityPolicy = AvailabilityPolicyModel
 	}
-	// deprecated
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
 	// shared core
 	if vcpuOk, ok := d.GetOk("vcpu"); ok && len(vcpuOk.([]interface{})) > 0 {
 		VcpuModel, err := ResourceIBMIsInstanceMapToInstanceVcpuPrototype(vcpuOk.([]interface{})[0].(map[string]interface{}))
@@ -3920,10 +3834,8 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 
 		// reserved ip changes
 
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
+		var reservedIp, reservedipv4, reservedipname string
 		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
 
 		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
 		if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -3941,13 +3853,7 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 			autodelete = reservedipautodeleteok.(bool)
 		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			err = fmt.Errorf("Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 			err = fmt.Errorf("Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -3958,11 +3864,8 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 				ID: &reservedIp,
 			}
 		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			if reservedipv4 != "" || reservedipname != "" || okAuto {
 				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
 				if reservedipv4 != "" {
 					primaryipobj.Address = &reservedipv4
 				}
@@ -4016,10 +3919,8 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 
 			// reserved ip changes
 
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
+			var reservedIp, reservedipv4, reservedipname string
 			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
 
 			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
 			if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -4037,13 +3938,7 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 				autodelete = reservedipautodeleteok.(bool)
 			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				err = fmt.Errorf("Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+			if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 				err = fmt.Errorf("Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4054,11 +3949,8 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 					ID: &reservedIp,
 				}
 			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+				if reservedipv4 != "" || reservedipname != "" || okAuto {
 					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
 					if reservedipv4 != "" {
 						primaryipobj.Address = &reservedipv4
 					}
@@ -4122,13 +4014,6 @@ func instanceCreateByTemplate(context context.Context, d *schema.ResourceData, m
 
 	}
 
-	if metadataServiceEnabled, ok := d.GetOkExists(isInstanceMetadataServiceEnabled); ok {
-		metadataServiceEnabledBool := metadataServiceEnabled.(bool)
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabledBool,
-		}
-	}
-
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
@@ -4209,13 +4094,6 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 		}
 		instanceproto.AvailabilityPolicy = AvailabilityPolicyModel
 	}
-	// deprecated
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
 	// shared core
 	if vcpuOk, ok := d.GetOk("vcpu"); ok && len(vcpuOk.([]interface{})) > 0 {
 		VcpuModel, err := ResourceIBMIsInstanceMapToInstanceVcpuPrototype(vcpuOk.([]interface{})[0].(map[string]interface{}))
@@ -4537,10 +4415,8 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 
 		// reserved ip changes
 
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
+		var reservedIp, reservedipv4, reservedipname string
 		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
 
 		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
 		if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -4558,13 +4434,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 			autodelete = reservedipautodeleteok.(bool)
 		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			err = fmt.Errorf("Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 			err = fmt.Errorf("Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4575,11 +4445,8 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 				ID: &reservedIp,
 			}
 		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			if reservedipv4 != "" || reservedipname != "" || okAuto {
 				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
 				if reservedipv4 != "" {
 					primaryipobj.Address = &reservedipv4
 				}
@@ -4633,10 +4500,8 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 			}
 			// reserved ip changes
 
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
+			var reservedIp, reservedipv4, reservedipname string
 			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
 
 			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
 			if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -4654,13 +4519,7 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 				autodelete = reservedipautodeleteok.(bool)
 			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				err = fmt.Errorf("Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+			if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 				err = fmt.Errorf("Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -4671,11 +4530,8 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 					ID: &reservedIp,
 				}
 			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+				if reservedipv4 != "" || reservedipname != "" || okAuto {
 					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
 					if reservedipv4 != "" {
 						primaryipobj.Address = &reservedipv4
 					}
@@ -4739,13 +4595,6 @@ func instanceCreateBySnapshot(context context.Context, d *schema.ResourceData, m
 
 	}
 
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
-
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
@@ -4826,13 +4675,6 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 		}
 		instanceproto.AvailabilityPolicy = AvailabilityPolicyModel
 	}
-	// deprecated
-	if availablePolicyItem, ok := d.GetOk(isInstanceAvailablePolicyHostFailure); ok {
-		hostFailure := availablePolicyItem.(string)
-		instanceproto.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPrototype{
-			HostFailure: &hostFailure,
-		}
-	}
 	// shared core
 	if vcpuOk, ok := d.GetOk("vcpu"); ok && len(vcpuOk.([]interface{})) > 0 {
 		VcpuModel, err := ResourceIBMIsInstanceMapToInstanceVcpuPrototype(vcpuOk.([]interface{})[0].(map[string]interface{}))
@@ -5091,10 +4933,8 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 
 		// reserved ip changes
 
-		var ipv4str, reservedIp, reservedipv4, reservedipname string
+		var reservedIp, reservedipv4, reservedipname string
 		var autodelete, okAuto bool
-		ipv4, _ := primnic[isInstanceNicPrimaryIpv4Address]
-		ipv4str = ipv4.(string)
 
 		primaryIpOk, ok := primnic[isInstanceNicPrimaryIP]
 		if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -5112,13 +4952,7 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 			reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 			autodelete = reservedipautodeleteok.(bool)
 		}
-		if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-			err = fmt.Errorf("Error creating instance, primary_network_interface error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+		if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 			err = fmt.Errorf("Error creating instance, primary_network_interface error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -5129,11 +4963,8 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 				ID: &reservedIp,
 			}
 		} else {
-			if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+			if reservedipv4 != "" || reservedipname != "" || okAuto {
 				primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-				if ipv4str != "" {
-					primaryipobj.Address = &ipv4str
-				}
 				if reservedipv4 != "" {
 					primaryipobj.Address = &reservedipv4
 				}
@@ -5187,10 +5018,8 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 			}
 			// reserved ip changes
 
-			var ipv4str, reservedIp, reservedipv4, reservedipname string
+			var reservedIp, reservedipv4, reservedipname string
 			var autodelete, okAuto bool
-			ipv4, _ := nic[isInstanceNicPrimaryIpv4Address]
-			ipv4str = ipv4.(string)
 
 			primaryIpOk, ok := nic[isInstanceNicPrimaryIP]
 			if ok && len(primaryIpOk.([]interface{})) > 0 {
@@ -5208,13 +5037,7 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 				reservedipautodeleteok, okAuto = primip[isInstanceNicReservedIpAutoDelete]
 				autodelete = reservedipautodeleteok.(bool)
 			}
-			if ipv4str != "" && reservedipv4 != "" && ipv4str != reservedipv4 {
-				err = fmt.Errorf("Error creating instance, network_interfaces error, use either primary_ipv4_address(%s) or primary_ip.0.address(%s)", ipv4str, reservedipv4)
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			if reservedIp != "" && (ipv4str != "" || reservedipv4 != "" || reservedipname != "") {
+			if reservedIp != "" && (reservedipv4 != "" || reservedipname != "") {
 				err = fmt.Errorf("Error creating instance, network_interfaces error, reserved_ip(%s) is mutually exclusive with other primary_ip attributes", reservedIp)
 				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Validation failed: %s", err.Error()), "ibm_is_instance", "create")
 				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -5225,11 +5048,8 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 					ID: &reservedIp,
 				}
 			} else {
-				if ipv4str != "" || reservedipv4 != "" || reservedipname != "" || okAuto {
+				if reservedipv4 != "" || reservedipname != "" || okAuto {
 					primaryipobj := &vpcv1.NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext{}
-					if ipv4str != "" {
-						primaryipobj.Address = &ipv4str
-					}
 					if reservedipv4 != "" {
 						primaryipobj.Address = &reservedipv4
 					}
@@ -5293,12 +5113,6 @@ func instanceCreateByVolume(context context.Context, d *schema.ResourceData, met
 
 	}
 
-	metadataServiceEnabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-	if metadataServiceEnabled {
-		instanceproto.MetadataService = &vpcv1.InstanceMetadataServicePrototype{
-			Enabled: &metadataServiceEnabled,
-		}
-	}
 	if metadataService := GetInstanceMetadataServiceOptions(d); metadataService != nil {
 		instanceproto.MetadataService = metadataService
 	}
@@ -5630,13 +5444,6 @@ func instanceGet(context context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 
-	if instance.AvailabilityPolicy != nil && instance.AvailabilityPolicy.HostFailure != nil {
-		if err = d.Set(isInstanceAvailablePolicyHostFailure, *instance.AvailabilityPolicy.HostFailure); err != nil {
-			err = fmt.Errorf("Error setting availability_policy_host_failure: %s", err)
-			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "read", "set-availability_policy_host_failure").GetDiag()
-		}
-	}
-
 	// volume_prototypes
 	volList, _ := setVolumePrototypesInState(d, instance, instanceC)
 	if err = d.Set("volume_prototypes", volList); err != nil {
@@ -5731,7 +5538,6 @@ func instanceGet(context context.Context, d *schema.ResourceData, meta interface
 		primaryIpList := make([]map[string]interface{}, 0)
 		currentPrimIp := map[string]interface{}{}
 		if instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
-			currentPrimNic[isInstanceNicPrimaryIpv4Address] = *instance.PrimaryNetworkInterface.PrimaryIP.Address
 			currentPrimIp[isInstanceNicReservedIpAddress] = *instance.PrimaryNetworkInterface.PrimaryIP.Address
 		}
 		if instance.PrimaryNetworkInterface.PrimaryIP.Href != nil {
@@ -5906,7 +5712,6 @@ func instanceGet(context context.Context, d *schema.ResourceData, meta interface
 
 				if intfc.PrimaryIP.Address != nil {
 					currentPrimIp[isInstanceNicReservedIpAddress] = *intfc.PrimaryIP.Address
-					currentNic[isInstanceNicPrimaryIpv4Address] = *intfc.PrimaryIP.Address
 				}
 				if intfc.PrimaryIP.Href != nil {
 					currentPrimIp[isInstanceNicReservedIpHref] = *intfc.PrimaryIP.Href
@@ -6188,10 +5993,6 @@ func instanceGet(context context.Context, d *schema.ResourceData, meta interface
 		}
 	}
 	if instance.MetadataService != nil {
-		if err = d.Set(isInstanceMetadataServiceEnabled, instance.MetadataService.Enabled); err != nil {
-			err = fmt.Errorf("Error setting metadata_service_enabled: %s", err)
-			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "read", "set-metadata_service_enabled").GetDiag()
-		}
 		metadataService := []map[string]interface{}{}
 		metadataServiceMap := map[string]interface{}{}
 
@@ -7715,32 +7516,6 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange(isInstanceMetadataServiceEnabled) && !d.IsNewResource() {
-		enabled := d.Get(isInstanceMetadataServiceEnabled).(bool)
-		updatedoptions := &vpcv1.UpdateInstanceOptions{
-			ID: &id,
-		}
-		instanceMDSPatchModel := &vpcv1.InstancePatch{
-			MetadataService: &vpcv1.InstanceMetadataServicePatch{
-				Enabled: &enabled,
-			},
-		}
-		instancePatch, err := instanceMDSPatchModel.AsPatch()
-		if err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("instanceMDSPatchModel.AsPatch() failed: %s", err.Error()), "ibm_is_instance", "update")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-		updatedoptions.InstancePatch = instancePatch
-
-		_, _, err = instanceC.UpdateInstanceWithContext(context, updatedoptions)
-		if err != nil {
-			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateInstanceWithContext failed: %s", err.Error()), "ibm_is_instance", "update")
-			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-			return tfErr.GetDiag()
-		}
-	}
-
 	if d.HasChange(isInstanceMetadataService) && !d.IsNewResource() {
 		metadataServiceIntf := d.Get(isInstanceMetadataService)
 		updatedoptions := &vpcv1.UpdateInstanceOptions{
@@ -7788,24 +7563,17 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange(isInstanceAvailablePolicyHostFailure) || d.HasChange("availability_policy") && !d.IsNewResource() {
+	if d.HasChange("availability_policy") && !d.IsNewResource() {
 
 		updatedoptions := &vpcv1.UpdateInstanceOptions{
 			ID: &id,
 		}
 		instanceAPHFPatchModel := &vpcv1.InstancePatch{}
-		if d.HasChange("availability_policy") {
-			availabilityPolicy, err := ResourceIBMIsInstanceMapToInstanceAvailabilityPolicyPatch(d.Get("availability_policy.0").(map[string]interface{}))
-			if err != nil {
-				return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "update", "parse-availability_policy").GetDiag()
-			}
-			instanceAPHFPatchModel.AvailabilityPolicy = availabilityPolicy
-		} else {
-			availablePolicyHostFailure := d.Get(isInstanceAvailablePolicyHostFailure).(string)
-			instanceAPHFPatchModel.AvailabilityPolicy = &vpcv1.InstanceAvailabilityPolicyPatch{
-				HostFailure: &availablePolicyHostFailure,
-			}
+		availabilityPolicy, err := ResourceIBMIsInstanceMapToInstanceAvailabilityPolicyPatch(d.Get("availability_policy.0").(map[string]interface{}))
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "update", "parse-availability_policy").GetDiag()
 		}
+		instanceAPHFPatchModel.AvailabilityPolicy = availabilityPolicy
 		instancePatch, err := instanceAPHFPatchModel.AsPatch()
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("instanceAPHFPatchModel.AsPatch() failed: %s", err.Error()), "ibm_is_instance", "update")
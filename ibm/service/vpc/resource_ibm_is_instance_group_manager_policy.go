@@ -139,8 +139,12 @@ func resourceIBMISInstanceGroupManagerPolicyCreate(context context.Context, d *s
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := conns.IbmLockManager.LockContext(context, isInsGrpKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager_policy", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isInsGrpKey)
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutCreate))
 	if healthError != nil {
@@ -214,8 +218,12 @@ func resourceIBMISInstanceGroupManagerPolicyUpdate(context context.Context, d *s
 		updateInstanceGroupManagerPolicyOptions.InstanceGroupManagerPolicyPatch = instanceGroupManagerPolicyAsPatch
 
 		isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-		conns.IbmMutexKV.Lock(isInsGrpKey)
-		defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+		if err := conns.IbmLockManager.LockContext(context, isInsGrpKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager_policy", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isInsGrpKey)
 
 		_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutUpdate))
 		if healthError != nil {
@@ -328,8 +336,12 @@ func resourceIBMISInstanceGroupManagerPolicyDelete(context context.Context, d *s
 	}
 
 	isInsGrpKey := "Instance_Group_Key_" + instanceGroupID
-	conns.IbmMutexKV.Lock(isInsGrpKey)
-	defer conns.IbmMutexKV.Unlock(isInsGrpKey)
+	if err := conns.IbmLockManager.LockContext(context, isInsGrpKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_group_manager_policy", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isInsGrpKey)
 
 	_, healthError := waitForHealthyInstanceGroup(instanceGroupID, meta, d.Timeout(schema.TimeoutDelete))
 	if healthError != nil {
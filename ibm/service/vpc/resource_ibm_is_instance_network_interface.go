@@ -346,8 +346,12 @@ func resourceIBMIsInstanceNetworkInterfaceCreate(context context.Context, d *sch
 	}
 
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := conns.IbmLockManager.LockContext(context, isNICKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_network_interface", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isNICKey)
 
 	networkInterface, _, err := vpcClient.CreateInstanceNetworkInterfaceWithContext(context, createInstanceNetworkInterfaceOptions)
 	if err != nil {
@@ -682,8 +686,12 @@ func resourceIBMIsInstanceNetworkInterfaceUpdate(context context.Context, d *sch
 	}
 	if hasChange {
 		isNICKey := "instance_key_" + instance_id
-		conns.IbmMutexKV.Lock(isNICKey)
-		defer conns.IbmMutexKV.Unlock(isNICKey)
+		if err := conns.IbmLockManager.LockContext(context, isNICKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_network_interface", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isNICKey)
 		updateInstanceNetworkInterfaceOptions.NetworkInterfacePatch, _ = patchVals.AsPatch()
 		_, _, err := vpcClient.UpdateInstanceNetworkInterfaceWithContext(context, updateInstanceNetworkInterfaceOptions)
 		if err != nil {
@@ -786,8 +794,12 @@ func resourceIBMIsInstanceNetworkInterfaceDelete(context context.Context, d *sch
 	instance_id := parts[0]
 	network_intf_id := parts[1]
 	isNICKey := "instance_key_" + instance_id
-	conns.IbmMutexKV.Lock(isNICKey)
-	defer conns.IbmMutexKV.Unlock(isNICKey)
+	if err := conns.IbmLockManager.LockContext(context, isNICKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_network_interface", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isNICKey)
 
 	deleteInstanceNetworkInterfaceOptions.SetInstanceID(instance_id)
 	deleteInstanceNetworkInterfaceOptions.SetID(network_intf_id)
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceIBMISInstanceStateUpgradeV0(t *testing.T) {
+	resource := ResourceIBMISInstance()
+	assert.Nil(t, resource.InternalValidate(nil, true))
+	assert.NotContains(t, resource.Schema, "availability_policy_host_failure")
+	assert.NotContains(t, resource.Schema, "metadata_service_enabled")
+
+	rawState, err := resource.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{
+		"id":                               "0717-instance",
+		"availability_policy_host_failure": "stop",
+		"metadata_service_enabled":         true,
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{
+				"subnet":               "0717-subnet",
+				"primary_ipv4_address": "10.240.0.6",
+				"primary_ip":           []interface{}{map[string]interface{}{"address": "10.240.0.6", "name": "ip1"}},
+			},
+		},
+		"network_interfaces": []interface{}{
+			map[string]interface{}{
+				"subnet":               "0717-subnet",
+				"primary_ipv4_address": "10.240.0.7",
+			},
+		},
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":                  "0717-instance",
+		"availability_policy": []interface{}{map[string]interface{}{"host_failure": "stop"}},
+		"metadata_service":    []interface{}{map[string]interface{}{"enabled": true}},
+		"primary_network_interface": []interface{}{
+			map[string]interface{}{
+				"subnet":     "0717-subnet",
+				"primary_ip": []interface{}{map[string]interface{}{"address": "10.240.0.6", "name": "ip1"}},
+			},
+		},
+		"network_interfaces": []interface{}{
+			map[string]interface{}{
+				"subnet":     "0717-subnet",
+				"primary_ip": []interface{}{map[string]interface{}{"address": "10.240.0.7"}},
+			},
+		},
+	}, rawState)
+}
@@ -703,7 +703,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_instance.testacc_instance", "name", name),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "zone", acc.ISZoneName),
-					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy_host_failure", "restart"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy.0.host_failure", "restart"),
 				),
 			},
 			{
@@ -714,7 +714,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_instance.testacc_instance", "name", name),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "zone", acc.ISZoneName),
-					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy_host_failure", "stop"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy.0.host_failure", "stop"),
 				),
 			},
 			{
@@ -725,7 +725,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_instance.testacc_instance", "name", name),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "zone", acc.ISZoneName),
-					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy_host_failure", "stop"),
+					resource.TestCheckResourceAttr("ibm_is_instance.testacc_instance", "availability_policy.0.host_failure", "stop"),
 				),
 			},
 		},
@@ -980,7 +980,7 @@ func TestAccIBMISInstance_metadata_service(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
 					resource.TestCheckResourceAttr(
-						"ibm_is_instance.testacc_instance", "metadata_service_enabled", "true"),
+						"ibm_is_instance.testacc_instance", "metadata_service.0.enabled", "true"),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "metadata_service.0.protocol", "https"),
 					resource.TestCheckResourceAttr(
@@ -992,7 +992,7 @@ func TestAccIBMISInstance_metadata_service(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMISInstanceExists("ibm_is_instance.testacc_instance", instance),
 					resource.TestCheckResourceAttr(
-						"ibm_is_instance.testacc_instance", "metadata_service_enabled", "true"),
+						"ibm_is_instance.testacc_instance", "metadata_service.0.enabled", "true"),
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "metadata_service.0.protocol", "http"),
 					resource.TestCheckResourceAttr(
@@ -1065,7 +1065,7 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 					resource.TestCheckResourceAttr(
 						"ibm_is_instance.testacc_instance", "name", name),
 					resource.TestCheckResourceAttr(
-						"ibm_is_instance.testacc_instance", "primary_network_interface.0.primary_ipv4_address", ipv4address),
+						"ibm_is_instance.testacc_instance", "primary_network_interface.0.primary_ip.0.address", ipv4address),
 				),
 			},
 		},
@@ -2542,7 +2542,9 @@ func testAccCheckIBMISInstanceConfigwithipv4(vpcname, subnetname, sshname, publi
 		profile = "%s"
 		primary_network_interface {
 		  subnet     = ibm_is_subnet.testacc_subnet.id
-		  primary_ipv4_address = "%s"
+		  primary_ip {
+		    address = "%s"
+		  }
 		}
 		vpc  = ibm_is_vpc.testacc_vpc.id
 		zone = "%s"
@@ -2871,7 +2873,9 @@ func testAccCheckIBMISInstanceConfigWithAvailablePolicyHostFailure_Updated(vpcna
 		name    = "%s"
 		image   = "%s"
 		profile = "%s"
-		availability_policy_host_failure = "stop"
+		availability_policy {
+		  host_failure = "stop"
+		}
 		primary_network_interface {
 		  subnet     = ibm_is_subnet.testacc_subnet.id
 		}
@@ -2911,7 +2915,9 @@ func testAccCheckIBMISInstanceConfigWithAvailablePolicyHostFailure_WithTemplate(
 		primary_network_interface {
 		  subnet = ibm_is_subnet.testacc_subnet.id
 		}
-		availability_policy_host_failure = "stop"
+		availability_policy {
+		  host_failure = "stop"
+		}
 		vpc       = ibm_is_vpc.testacc_vpc.id
 		zone      = "%s"
 		keys      = [ibm_is_ssh_key.testacc_sshkey.id]
@@ -5026,7 +5032,9 @@ func testAccCheckIBMISInstanceConfigWithAvailabilityPolicy_Default(vpcname, subn
 		availability {
 			class = "spot"
 		}
-		availability_policy_host_failure = "restart"
+		availability_policy {
+		  host_failure = "restart"
+		}
 		primary_network_interface {
 		  subnet     = ibm_is_subnet.testacc_subnet.id
 		}
@@ -482,8 +482,12 @@ func instanceVolAttachmentCreate(context context.Context, d *schema.ResourceData
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := conns.IbmLockManager.LockContext(context, isInstanceKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_volume_attachment", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isInstanceKey)
 
 	instanceVolAtt, _, err := sess.CreateInstanceVolumeAttachmentWithContext(context, instanceVolAttproto)
 	if err != nil {
@@ -1042,8 +1046,12 @@ func instanceVolAttDelete(context context.Context, d *schema.ResourceData, meta
 	}
 
 	isInstanceKey := "instance_key_" + instanceId
-	conns.IbmMutexKV.Lock(isInstanceKey)
-	defer conns.IbmMutexKV.Unlock(isInstanceKey)
+	if err := conns.IbmLockManager.LockContext(context, isInstanceKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance_volume_attachment", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isInstanceKey)
 
 	_, err = instanceC.DeleteInstanceVolumeAttachmentWithContext(context, deleteInstanceVolAttOptions)
 	if err != nil {
@@ -318,8 +318,12 @@ func resourceIBMISLBListenerCreate(context context.Context, d *schema.ResourceDa
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	err := lbListenerCreate(context, d, meta, lbID, protocol, defPool, certificateCRN, listener, uri, port, portMin, portMax, connLimit, httpStatusCode)
 	if err != nil {
@@ -812,8 +816,12 @@ func lbListenerUpdate(context context.Context, d *schema.ResourceData, meta inte
 		updateLoadBalancerListenerOptions.LoadBalancerListenerPatch = loadBalancerListenerPatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isLBKey)
 
 		_, err = isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -856,8 +864,12 @@ func resourceIBMISLBListenerDelete(context context.Context, d *schema.ResourceDa
 	lbListenerID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diagEerr := lbListenerDelete(context, d, meta, lbID, lbListenerID)
 	if diagEerr != nil {
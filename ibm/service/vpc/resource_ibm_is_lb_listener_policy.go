@@ -608,8 +608,12 @@ func lbListenerPolicyCreate(context context.Context, d *schema.ResourceData, met
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -938,8 +942,12 @@ func lbListenerPolicyUpdate(context context.Context, d *schema.ResourceData, met
 		}
 		updatePolicyOptions.LoadBalancerListenerPolicyPatch = loadBalancerListenerPolicyPatch
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isLBKey)
 
 		_, err = isWaitForLbAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -977,8 +985,12 @@ func resourceIBMISLBListenerPolicyDelete(context context.Context, d *schema.Reso
 	policyID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diagErr := lbListenerPolicyDelete(context, d, meta, lbID, listenerID, policyID)
 	if diagErr != nil {
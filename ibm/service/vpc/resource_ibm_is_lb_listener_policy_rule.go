@@ -268,8 +268,12 @@ func lbListenerPolicyRuleCreate(context context.Context, d *schema.ResourceData,
 	}
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy_rule", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
@@ -517,8 +521,12 @@ func lbListenerPolicyRuleUpdate(context context.Context, d *schema.ResourceData,
 		updatePolicyRuleOptions.LoadBalancerListenerPolicyRulePatch = loadBalancerListenerPolicyRulePatch
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy_rule", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isLBKey)
 
 		_, err = isWaitForLoadbalancerAvailable(sess, lbID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
@@ -558,8 +566,12 @@ func resourceIBMISLBListenerPolicyRuleDelete(context context.Context, d *schema.
 	ruleID := parts[3]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_listener_policy_rule", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diagErr := lbListenerPolicyRuleDelete(context, d, meta, lbID, listenerID, policyID, ruleID)
 	if diagErr != nil {
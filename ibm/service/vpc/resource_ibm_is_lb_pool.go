@@ -735,8 +735,12 @@ func lbPoolUpdate(context context.Context, d *schema.ResourceData, meta interfac
 		loadBalancerPoolPatchModel.Protocol = &protocol
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isLBKey)
 		_, err := isWaitForLBAvailable(sess, lbID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForLBAvailable failed: %s", err.Error()), "ibm_is_lb_pool", "update")
@@ -804,8 +808,12 @@ func resourceIBMISLBPoolDelete(context context.Context, d *schema.ResourceData,
 	lbPoolID := parts[1]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diag := lbPoolDelete(context, d, meta, lbID, lbPoolID)
 	if diag != nil {
@@ -171,8 +171,12 @@ func resourceIBMISLBPoolMemberCreate(context context.Context, d *schema.Resource
 	var weight int64
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_pool_member", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diag := lbpMemberCreate(context, d, meta, lbID, lbPoolID, port64, weight)
 	if diag != nil {
@@ -438,8 +442,12 @@ func lbpmemberUpdate(context context.Context, d *schema.ResourceData, meta inter
 		weight := int64(d.Get(isLBPoolMemberWeight).(int))
 
 		isLBKey := "load_balancer_key_" + lbID
-		conns.IbmMutexKV.Lock(isLBKey)
-		defer conns.IbmMutexKV.Unlock(isLBKey)
+		if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_pool_member", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isLBKey)
 
 		_, err = isWaitForLBPoolActive(sess, lbID, lbPoolID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
@@ -537,8 +545,12 @@ func resourceIBMISLBPoolMemberDelete(context context.Context, d *schema.Resource
 	lbPoolMemID := parts[2]
 
 	isLBKey := "load_balancer_key_" + lbID
-	conns.IbmMutexKV.Lock(isLBKey)
-	defer conns.IbmMutexKV.Unlock(isLBKey)
+	if err := conns.IbmLockManager.LockContext(context, isLBKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_lb_pool_member", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isLBKey)
 
 	diag := lbpmemberDelete(context, d, meta, lbID, lbPoolID, lbPoolMemID)
 	if diag != nil {
@@ -363,8 +363,12 @@ func resourceIBMISSecurityGroupRuleCreate(context context.Context, d *schema.Res
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group_rule", "create", "parse-request-body").GetDiag()
 	}
 	isSecurityGroupRuleKey := "security_group_rule_key_" + parsed.secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.IbmLockManager.LockContext(context, isSecurityGroupRuleKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_rule", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSecurityGroupRuleKey)
 
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID:            &parsed.secgrpID,
@@ -1133,8 +1137,12 @@ func resourceIBMISSecurityGroupRuleUpdate(context context.Context, d *schema.Res
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.IbmLockManager.LockContext(context, isSecurityGroupRuleKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_rule", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSecurityGroupRuleKey)
 
 	_, _, err = sess.UpdateSecurityGroupRuleWithContext(context, updateSecurityGroupRuleOptions)
 	if err != nil {
@@ -1158,8 +1166,12 @@ func resourceIBMISSecurityGroupRuleDelete(context context.Context, d *schema.Res
 	}
 
 	isSecurityGroupRuleKey := "security_group_rule_key_" + secgrpID
-	conns.IbmMutexKV.Lock(isSecurityGroupRuleKey)
-	defer conns.IbmMutexKV.Unlock(isSecurityGroupRuleKey)
+	if err := conns.IbmLockManager.LockContext(context, isSecurityGroupRuleKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_rule", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSecurityGroupRuleKey)
 
 	getSecurityGroupRuleOptions := &vpcv1.GetSecurityGroupRuleOptions{
 		SecurityGroupID: &secgrpID,
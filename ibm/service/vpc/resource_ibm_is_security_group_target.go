@@ -116,8 +116,12 @@ func resourceIBMISSecurityGroupTargetCreate(context context.Context, d *schema.R
 	createSecurityGroupTargetBindingOptions.SecurityGroupID = &securityGroupID
 	createSecurityGroupTargetBindingOptions.ID = &targetID
 	isSGTargetPrefixKey := "security_group_key_" + targetID
-	conns.IbmMutexKV.Lock(isSGTargetPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isSGTargetPrefixKey)
+	if err := conns.IbmLockManager.LockContext(context, isSGTargetPrefixKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_target", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSGTargetPrefixKey)
 
 	sg, _, err := sess.CreateSecurityGroupTargetBindingWithContext(context, createSecurityGroupTargetBindingOptions)
 	if err != nil || sg == nil {
@@ -266,8 +270,12 @@ func resourceIBMISSecurityGroupTargetDelete(context context.Context, d *schema.R
 	}
 	// Acquire a lock based on the target ID to prevent simultaneous delete on same target
 	isSGTargetPrefixKey := "security_group_key_" + securityGroupTargetID
-	conns.IbmMutexKV.Lock(isSGTargetPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isSGTargetPrefixKey)
+	if err := conns.IbmLockManager.LockContext(context, isSGTargetPrefixKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_security_group_target", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSGTargetPrefixKey)
 
 	deleteSecurityGroupTargetBindingOptions := sess.NewDeleteSecurityGroupTargetBindingOptions(securityGroupID, securityGroupTargetID)
 	response, err = sess.DeleteSecurityGroupTargetBindingWithContext(context, deleteSecurityGroupTargetBindingOptions)
@@ -303,8 +303,12 @@ func resourceIBMISSubnetCreate(context context.Context, d *schema.ResourceData,
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "create", "parse-ipv4").GetDiag()
 	}
 	isSubnetKey := "subnet_key_" + vpc + "_" + zone
-	conns.IbmMutexKV.Lock(isSubnetKey)
-	defer conns.IbmMutexKV.Unlock(isSubnetKey)
+	if err := conns.IbmLockManager.LockContext(context, isSubnetKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_subnet", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isSubnetKey)
 
 	acl := ""
 	if nwacl, ok := d.GetOk(isSubnetNetworkACL); ok {
@@ -354,8 +354,12 @@ func resourceIBMisVirtualEndpointGatewayCreate(context context.Context, d *schem
 	}
 	if vpcID != "" {
 		isVPCKey := "vpe_vpc_key_" + vpcID
-		conns.IbmMutexKV.Lock(isVPCKey)
-		defer conns.IbmMutexKV.Unlock(isVPCKey)
+		if err := conns.IbmLockManager.LockContext(context, isVPCKey); err != nil {
+			tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_virtual_endpoint_gateway", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		defer conns.IbmLockManager.Unlock(isVPCKey)
 	}
 
 	// update option
@@ -129,8 +129,12 @@ func resourceIBMISVpcAddressPrefixCreate(context context.Context, d *schema.Reso
 	}
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.IbmLockManager.LockContext(context, isVPCAddressPrefixKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isVPCAddressPrefixKey)
 
 	err := vpcAddressPrefixCreate(context, d, meta, prefixName, zoneName, cidr, vpcID, isDefault)
 	if err != nil {
@@ -272,8 +276,12 @@ func resourceIBMISVpcAddressPrefixUpdate(context context.Context, d *schema.Reso
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.IbmLockManager.LockContext(context, isVPCAddressPrefixKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isVPCAddressPrefixKey)
 
 	if d.HasChange(isVPCAddressPrefixPrefixName) {
 		name = d.Get(isVPCAddressPrefixPrefixName).(string)
@@ -339,8 +347,12 @@ func resourceIBMISVpcAddressPrefixDelete(context context.Context, d *schema.Reso
 	addrPrefixID := parts[1]
 
 	isVPCAddressPrefixKey := "vpc_address_prefix_key_" + vpcID
-	conns.IbmMutexKV.Lock(isVPCAddressPrefixKey)
-	defer conns.IbmMutexKV.Unlock(isVPCAddressPrefixKey)
+	if err := conns.IbmLockManager.LockContext(context, isVPCAddressPrefixKey); err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	defer conns.IbmLockManager.Unlock(isVPCAddressPrefixKey)
 
 	error := vpcAddressPrefixDelete(context, d, meta, vpcID, addrPrefixID)
 	if error != nil {
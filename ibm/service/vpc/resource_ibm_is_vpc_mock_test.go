@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/provider"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/unittest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// mockIBMISVPCs mocks the VPC APIs called by the ibm_is_vpc resource.
+func mockIBMISVPCs(server *unittest.MockServer) *unittest.MockCollection {
+	server.Service("IBMCLOUD_GS_API_ENDPOINT").Respond("POST /v3/resources/search", http.StatusOK, `{"items": []}`)
+
+	service := server.Service("IBMCLOUD_IS_NG_API_ENDPOINT")
+	service.Respond("GET /vpcs/{id}/address_prefixes", http.StatusOK, `{"address_prefixes": [], "limit": 50}`)
+	service.Respond("GET /subnets", http.StatusOK, `{"subnets": [], "limit": 50}`)
+	service.Respond("GET /security_groups", http.StatusOK, `{"security_groups": [], "limit": 50}`)
+	return service.Collection("/vpcs", &unittest.MockCollection{
+		ListKey: "vpcs",
+		New: func(id string, body map[string]interface{}) map[string]interface{} {
+			crn := fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/%s::vpc:%s", unittest.MockRegion, unittest.MockAccountID, id)
+			return map[string]interface{}{
+				"id":             id,
+				"crn":            crn,
+				"href":           service.URL() + "/vpcs/" + id,
+				"name":           body["name"],
+				"classic_access": false,
+				"created_at":     "2026-01-01T00:00:00Z",
+				"status":         "available",
+				"health_state":   "ok",
+				"resource_group": map[string]interface{}{
+					"id":   unittest.MockResourceGroupID,
+					"name": "Default",
+				},
+				"default_network_acl": map[string]interface{}{
+					"id":   id + "-acl",
+					"name": "default-acl",
+					"crn":  crn + "-acl",
+				},
+				"default_security_group": map[string]interface{}{
+					"id":   id + "-sg",
+					"name": "default-sg",
+					"crn":  crn + "-sg",
+				},
+				"default_routing_table": map[string]interface{}{
+					"id":   id + "-rt",
+					"name": "default-rt",
+					"crn":  crn + "-rt",
+				},
+				"cse_source_ips": []interface{}{},
+				"dns": map[string]interface{}{
+					"enable_hub": false,
+					"resolver": map[string]interface{}{
+						"type": "system",
+					},
+				},
+			}
+		},
+	})
+}
+
+func TestUnitIBMISVPCMock(t *testing.T) {
+	server := unittest.NewMockServer(t)
+	vpcs := mockIBMISVPCs(server)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { unittest.SkipWithoutTerraform(t) },
+		ProviderFactories: server.ProviderFactories(provider.Provider),
+		CheckDestroy: func(s *terraform.State) error {
+			for _, rs := range s.RootModule().Resources {
+				if _, found := vpcs.Get(rs.Primary.ID); found {
+					return fmt.Errorf("VPC still exists: %s", rs.Primary.ID)
+				}
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitIBMISVPCConfig("vpc1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "vpc1"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "status", "available"),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "resource_group", unittest.MockResourceGroupID),
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "default_network_acl", "mock-0001-acl"),
+				),
+			},
+			{
+				Config: testUnitIBMISVPCConfig("vpc2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_is_vpc.testacc_vpc", "name", "vpc2"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitIBMISVPCConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}`, name)
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// vpcIdentity returns the identity of a VPC resource whose ID is made of the given attributes joined with "/".
+func vpcIdentity(attributes ...string) flex.CompositeIdentity {
+	return flex.CompositeIdentity{
+		Attributes: attributes,
+		Regional:   true,
+	}
+}
+
+// resourceIdentities maps the VPC resources to their identity. The order of the attributes must
+// match the order of the parts of the ID set by the resource.
+var resourceIdentities = map[string]flex.CompositeIdentity{
+	"ibm_is_backup_policy":                                   vpcIdentity("id"),
+	"ibm_is_backup_policy_plan":                              vpcIdentity("backup_policy_id", "id"),
+	"ibm_is_bare_metal_server":                               vpcIdentity("id"),
+	"ibm_is_bare_metal_server_network_attachment":            vpcIdentity("bare_metal_server_id", "id"),
+	"ibm_is_bare_metal_server_network_interface":             vpcIdentity("bare_metal_server_id", "id"),
+	"ibm_is_bare_metal_server_network_interface_floating_ip": vpcIdentity("bare_metal_server_id", "network_interface_id", "id"),
+	"ibm_is_cluster_network":                                 vpcIdentity("id"),
+	"ibm_is_cluster_network_interface":                       vpcIdentity("cluster_network_id", "id"),
+	"ibm_is_cluster_network_subnet":                          vpcIdentity("cluster_network_id", "id"),
+	"ibm_is_cluster_network_subnet_reserved_ip":              vpcIdentity("cluster_network_id", "cluster_network_subnet_id", "id"),
+	"ibm_is_dedicated_host":                                  vpcIdentity("id"),
+	"ibm_is_dedicated_host_group":                            vpcIdentity("id"),
+	"ibm_is_floating_ip":                                     vpcIdentity("id"),
+	"ibm_is_flow_log":                                        vpcIdentity("id"),
+	"ibm_is_ike_policy":                                      vpcIdentity("id"),
+	"ibm_is_image":                                           vpcIdentity("id"),
+	"ibm_is_image_export_job":                                vpcIdentity("image_id", "id"),
+	"ibm_is_instance":                                        vpcIdentity("id"),
+	"ibm_is_instance_cluster_network_attachment":             vpcIdentity("instance_id", "id"),
+	"ibm_is_instance_group":                                  vpcIdentity("id"),
+	"ibm_is_instance_group_manager":                          vpcIdentity("instance_group_id", "id"),
+	"ibm_is_instance_group_manager_action":                   vpcIdentity("instance_group_id", "instance_group_manager_id", "id"),
+	"ibm_is_instance_group_manager_policy":                   vpcIdentity("instance_group_id", "instance_group_manager_id", "id"),
+	"ibm_is_instance_group_membership":                       vpcIdentity("instance_group_id", "id"),
+	"ibm_is_instance_network_attachment":                     vpcIdentity("instance_id", "id"),
+	"ibm_is_instance_network_interface":                      vpcIdentity("instance_id", "id"),
+	"ibm_is_instance_network_interface_floating_ip":          vpcIdentity("instance_id", "network_interface_id", "id"),
+	"ibm_is_instance_template":                               vpcIdentity("id"),
+	"ibm_is_instance_volume_attachment":                      vpcIdentity("instance_id", "id"),
+	"ibm_is_ipsec_policy":                                    vpcIdentity("id"),
+	"ibm_is_lb":                                              vpcIdentity("id"),
+	"ibm_is_lb_listener":                                     vpcIdentity("lb_id", "id"),
+	"ibm_is_lb_listener_policy":                              vpcIdentity("lb_id", "listener_id", "id"),
+	"ibm_is_lb_listener_policy_rule":                         vpcIdentity("lb_id", "listener_id", "policy_id", "id"),
+	"ibm_is_lb_pool":                                         vpcIdentity("lb_id", "id"),
+	"ibm_is_lb_pool_member":                                  vpcIdentity("lb_id", "pool_id", "id"),
+	"ibm_is_network_acl":                                     vpcIdentity("id"),
+	"ibm_is_network_acl_rule":                                vpcIdentity("network_acl_id", "id"),
+	"ibm_is_placement_group":                                 vpcIdentity("id"),
+	"ibm_is_private_path_service_gateway":                    vpcIdentity("id"),
+	"ibm_is_private_path_service_gateway_account_policy":     vpcIdentity("private_path_service_gateway_id", "id"),
+	"ibm_is_public_address_range":                            vpcIdentity("id"),
+	"ibm_is_public_gateway":                                  vpcIdentity("id"),
+	"ibm_is_reservation":                                     vpcIdentity("id"),
+	"ibm_is_security_group":                                  vpcIdentity("id"),
+	"ibm_is_security_group_rule": {
+		Attributes: []string{"security_group_id", "id"},
+		Separator:  ".",
+		Regional:   true,
+	},
+	"ibm_is_security_group_target":                     vpcIdentity("security_group_id", "id"),
+	"ibm_is_share":                                     vpcIdentity("id"),
+	"ibm_is_share_mount_target":                        vpcIdentity("share_id", "id"),
+	"ibm_is_share_snapshot":                            vpcIdentity("share_id", "id"),
+	"ibm_is_snapshot":                                  vpcIdentity("id"),
+	"ibm_is_snapshot_consistency_group":                vpcIdentity("id"),
+	"ibm_is_ssh_key":                                   vpcIdentity("id"),
+	"ibm_is_subnet":                                    vpcIdentity("id"),
+	"ibm_is_subnet_reserved_ip":                        vpcIdentity("subnet_id", "id"),
+	"ibm_is_virtual_endpoint_gateway":                  vpcIdentity("id"),
+	"ibm_is_virtual_endpoint_gateway_ip":               vpcIdentity("endpoint_gateway_id", "id"),
+	"ibm_is_virtual_endpoint_gateway_resource_binding": vpcIdentity("endpoint_gateway_id", "id"),
+	"ibm_is_virtual_network_interface":                 vpcIdentity("id"),
+	"ibm_is_virtual_network_interface_floating_ip":     vpcIdentity("virtual_network_interface_id", "id"),
+	"ibm_is_virtual_network_interface_ip":              vpcIdentity("virtual_network_interface_id", "id"),
+	"ibm_is_volume":                                    vpcIdentity("id"),
+	"ibm_is_vpc":                                       vpcIdentity("id"),
+	"ibm_is_vpc_address_prefix":                        vpcIdentity("vpc_id", "id"),
+	"ibm_is_vpc_dns_resolution_binding":                vpcIdentity("vpc_id", "id"),
+	"ibm_is_vpc_routing_table":                         vpcIdentity("vpc_id", "id"),
+	"ibm_is_vpc_routing_table_route":                   vpcIdentity("vpc_id", "routing_table_id", "id"),
+	"ibm_is_vpn_gateway":                               vpcIdentity("id"),
+	"ibm_is_vpn_gateway_advertised_cidr":               vpcIdentity("vpn_gateway_id", "cidr"),
+	"ibm_is_vpn_gateway_connection":                    vpcIdentity("vpn_gateway_id", "id"),
+	"ibm_is_vpn_server":                                vpcIdentity("id"),
+	"ibm_is_vpn_server_route":                          vpcIdentity("vpn_server_id", "id"),
+}
+
+// WithResourceIdentity adds the identity of the named VPC resource to its definition, so that it can be
+// imported with an `import` block using `identity` instead of the ID. Resources without an identity are
+// returned unchanged.
+func WithResourceIdentity(name string, resource *schema.Resource) *schema.Resource {
+	identity, ok := resourceIdentities[name]
+	if !ok {
+		return resource
+	}
+	return identity.Apply(resource)
+}
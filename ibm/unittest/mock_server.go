@@ -0,0 +1,444 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+//
+// Mock IBM Cloud API server used by the resource/data source unit tests.
+//
+
+const (
+	MockAPIKey          = "mock-api-key" // pragma: allowlist secret
+	MockRegion          = "us-south"
+	MockAccountID       = "mockaccount0000000000000000000000"
+	MockResourceGroupID = "mockresourcegroup000000000000000"
+	MockUserID          = "IBMid-mockuser"
+	MockUserEmail       = "mockuser@ibm.com"
+)
+
+// MockServer is an HTTP server that stands in for the IBM Cloud APIs. Every service of the endpoints
+// file is served under its own path prefix, IAM returns tokens for a mock user, and the requests that
+// are not mocked fail with a 404 response.
+//
+// A typical unit test mocks the API calls of a resource and lets resource.UnitTest drive it:
+//
+//	server := NewMockServer(t)
+//	vpcs := server.Service("IBMCLOUD_IS_NG_API_ENDPOINT").Collection("/vpcs", &MockCollection{ListKey: "vpcs"})
+//	resource.UnitTest(t, resource.TestCase{
+//		PreCheck:          func() { SkipWithoutTerraform(t) },
+//		ProviderFactories: server.ProviderFactories(provider.Provider),
+//		...
+//	})
+type MockServer struct {
+	*httptest.Server
+
+	t   testing.TB
+	mux *http.ServeMux
+
+	mu       sync.Mutex
+	requests []MockRequest
+}
+
+// MockRequest is a request received by the mock server.
+type MockRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// NewMockServer starts a mock server, which is closed when the test ends.
+func NewMockServer(t testing.TB) *MockServer {
+	t.Helper()
+
+	server := &MockServer{
+		t:   t,
+		mux: http.NewServeMux(),
+	}
+	server.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Logf("[WARN] No mock response for %s %s", r.Method, r.URL.Path)
+		WriteMockError(w, http.StatusNotFound, "not_found", fmt.Sprintf("No mock response for %s %s", r.Method, r.URL.Path))
+	})
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	t.Cleanup(server.Close)
+
+	server.Service("IBMCLOUD_IAM_API_ENDPOINT").Handle("POST /identity/token", server.serveIAMToken)
+	return server
+}
+
+func (s *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, MockRequest{Method: r.Method, Path: r.URL.Path, Body: body})
+	s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// serveIAMToken returns an IAM access token of the mock user.
+func (s *MockServer) serveIAMToken(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	expiration := now.Add(time.Hour)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iam_id":  MockUserID,
+		"id":      MockUserID,
+		"sub":     MockUserEmail,
+		"email":   MockUserEmail,
+		"account": map[string]interface{}{"bss": MockAccountID},
+		"iss":     "https://iam.cloud.ibm.com/identity",
+		"iat":     now.Unix(),
+		"exp":     expiration.Unix(),
+	}).SignedString([]byte("mock"))
+	if err != nil {
+		WriteMockError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	WriteMockJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  token,
+		"refresh_token": "mock-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    expiration.Unix(),
+	})
+}
+
+// Requests returns the requests received by the mock server, in order.
+func (s *MockServer) Requests() []MockRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]MockRequest(nil), s.requests...)
+}
+
+// Service returns the service of the endpoints file with the given key, such as "IBMCLOUD_IS_NG_API_ENDPOINT".
+func (s *MockServer) Service(key string) *MockService {
+	return &MockService{
+		server: s,
+		prefix: mockServicePrefix(key),
+	}
+}
+
+// mockServicePrefix returns the path under which the service is served, such as "/is-ng" for "IBMCLOUD_IS_NG_API_ENDPOINT".
+func mockServicePrefix(key string) string {
+	name := strings.TrimPrefix(key, "IBMCLOUD_")
+	name = strings.TrimSuffix(name, "_API_ENDPOINT")
+	name = strings.TrimSuffix(name, "_ENDPOINT")
+	return "/" + strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+// Config returns the provider configuration that targets the mock server through an endpoints file.
+func (s *MockServer) Config() conns.Config {
+	s.t.Helper()
+
+	endpoints := map[string]interface{}{}
+	// Every service of the endpoints file is redirected to the mock server, so that a request that is not
+	// mocked fails instead of reaching IBM Cloud.
+	for _, key := range conns.EndpointsFileServices() {
+		endpoints[key] = map[string]interface{}{
+			"public": map[string]string{
+				MockRegion: s.URL + mockServicePrefix(key),
+			},
+		}
+	}
+	content, err := json.Marshal(endpoints)
+	if err != nil {
+		s.t.Fatalf("Error marshalling the endpoints file: %s", err)
+	}
+	endpointsFile := filepath.Join(s.t.TempDir(), "endpoints.json")
+	if err := os.WriteFile(endpointsFile, content, 0600); err != nil {
+		s.t.Fatalf("Error writing the endpoints file: %s", err)
+	}
+
+	return conns.Config{
+		BluemixAPIKey:  MockAPIKey,
+		Region:         MockRegion,
+		ResourceGroup:  MockResourceGroupID,
+		BluemixTimeout: 60 * time.Second,
+		RetryDelay:     conns.RetryAPIDelay,
+		Visibility:     "public",
+		EndpointsFile:  endpointsFile,
+	}
+}
+
+// ClientSession returns a client session that targets the mock server.
+func (s *MockServer) ClientSession() conns.ClientSession {
+	s.t.Helper()
+
+	config := s.Config()
+	session, err := config.ClientSession()
+	if err != nil {
+		s.t.Fatalf("Error configuring the client session: %s", err)
+	}
+	return session
+}
+
+// ProviderFactories returns the provider factories of resource.UnitTest. The provider built by
+// newProvider, usually provider.Provider, is configured to target the mock server.
+func (s *MockServer) ProviderFactories(newProvider func() *schema.Provider) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"ibm": func() (*schema.Provider, error) {
+			p := newProvider()
+			p.ConfigureContextFunc = func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				config := s.Config()
+				session, err := config.ClientSession()
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				return session, nil
+			}
+			return p, nil
+		},
+	}
+}
+
+// SkipWithoutTerraform skips the test when resource.UnitTest cannot find a Terraform CLI without
+// downloading it, as in sandboxes without network access.
+func SkipWithoutTerraform(t testing.TB) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI not found in PATH, set TF_ACC_TERRAFORM_PATH to run this test")
+	}
+}
+
+// MockService is a service of the mock server. The paths of its handlers are relative to the
+// endpoint of the service.
+type MockService struct {
+	server *MockServer
+	prefix string
+}
+
+// URL returns the endpoint of the service.
+func (m *MockService) URL() string {
+	return m.server.URL + m.prefix
+}
+
+// Handle registers the handler of the requests that match the pattern, such as "GET /vpcs/{id}".
+// The pattern follows the syntax of http.ServeMux and its wildcards are available with PathValue.
+func (m *MockService) Handle(pattern string, handler http.HandlerFunc) {
+	method, path, found := strings.Cut(pattern, " ")
+	if !found {
+		method, path = "", pattern
+	}
+	m.server.mux.HandleFunc(strings.TrimSpace(method+" "+m.prefix+path), handler)
+}
+
+// Respond registers a canned response of the requests that match the pattern. The body is encoded
+// as JSON, unless it is a string or nil.
+func (m *MockService) Respond(pattern string, status int, body interface{}) {
+	m.Handle(pattern, func(w http.ResponseWriter, r *http.Request) {
+		WriteMockJSON(w, status, body)
+	})
+}
+
+// Collection registers the handlers of a REST collection at path, backed by the given collection.
+func (m *MockService) Collection(path string, collection *MockCollection) *MockCollection {
+	path = strings.TrimSuffix(path, "/")
+	m.Handle("POST "+path, collection.create)
+	m.Handle("GET "+path, collection.list)
+	m.Handle("GET "+path+"/{id}", collection.get)
+	m.Handle("PATCH "+path+"/{id}", collection.update)
+	m.Handle("PUT "+path+"/{id}", collection.update)
+	m.Handle("DELETE "+path+"/{id}", collection.delete)
+	return collection
+}
+
+// MockCollection is an in-memory REST collection. Items are created with POST, listed with GET,
+// read with GET, updated with PATCH or PUT and deleted with DELETE.
+type MockCollection struct {
+	// ListKey is the property of the list response that holds the items, such as "vpcs".
+	ListKey string
+
+	// New builds a new item from its ID and the body of the create request. By default the item is
+	// the body of the request with the ID.
+	New func(id string, body map[string]interface{}) map[string]interface{}
+
+	// Delete is called when an item is deleted and returns whether the item is kept, for APIs that
+	// keep deleted items in a final state. By default the item is removed.
+	Delete func(item map[string]interface{}) bool
+
+	mu    sync.Mutex
+	ids   []string
+	items map[string]map[string]interface{}
+}
+
+// Get returns the item with the given ID.
+func (c *MockCollection) Get(id string) (map[string]interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	item, ok := c.items[id]
+	return item, ok
+}
+
+// Put adds or replaces the item with the given ID.
+func (c *MockCollection) Put(id string, item map[string]interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.put(id, item)
+}
+
+func (c *MockCollection) put(id string, item map[string]interface{}) {
+	if c.items == nil {
+		c.items = map[string]map[string]interface{}{}
+	}
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+func (c *MockCollection) remove(id string) {
+	delete(c.items, id)
+	for i, itemID := range c.ids {
+		if itemID == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *MockCollection) create(w http.ResponseWriter, r *http.Request) {
+	body, ok := readMockBody(w, r)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	id := fmt.Sprintf("mock-%04d", len(c.ids)+1)
+	item := body
+	if c.New != nil {
+		item = c.New(id, body)
+	} else {
+		item["id"] = id
+	}
+	c.put(id, item)
+	c.mu.Unlock()
+
+	WriteMockJSON(w, http.StatusCreated, item)
+}
+
+func (c *MockCollection) list(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	items := make([]interface{}, 0, len(c.ids))
+	for _, id := range c.ids {
+		items = append(items, c.items[id])
+	}
+	c.mu.Unlock()
+
+	WriteMockJSON(w, http.StatusOK, map[string]interface{}{
+		c.ListKey:     items,
+		"total_count": len(items),
+		"limit":       len(items) + 1,
+	})
+}
+
+func (c *MockCollection) get(w http.ResponseWriter, r *http.Request) {
+	item, ok := c.Get(r.PathValue("id"))
+	if !ok {
+		WriteMockError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s not found", r.PathValue("id")))
+		return
+	}
+	WriteMockJSON(w, http.StatusOK, item)
+}
+
+func (c *MockCollection) update(w http.ResponseWriter, r *http.Request) {
+	body, ok := readMockBody(w, r)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	item, found := c.items[r.PathValue("id")]
+	if found {
+		for key, value := range body {
+			item[key] = value
+		}
+	}
+	c.mu.Unlock()
+
+	if !found {
+		WriteMockError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s not found", r.PathValue("id")))
+		return
+	}
+	WriteMockJSON(w, http.StatusOK, item)
+}
+
+func (c *MockCollection) delete(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	c.mu.Lock()
+	item, found := c.items[id]
+	if found && (c.Delete == nil || !c.Delete(item)) {
+		c.remove(id)
+	}
+	c.mu.Unlock()
+
+	if !found {
+		WriteMockError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s not found", id))
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func readMockBody(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	body := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		WriteMockError(w, http.StatusBadRequest, "bad_request", fmt.Sprintf("Invalid JSON body: %s", err))
+		return nil, false
+	}
+	return body, true
+}
+
+// WriteMockJSON writes a response with the given status. The body is encoded as JSON, unless it is a
+// string or nil.
+func WriteMockJSON(w http.ResponseWriter, status int, body interface{}) {
+	switch body := body.(type) {
+	case nil:
+		w.WriteHeader(status)
+	case string:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		io.WriteString(w, body)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+// WriteMockError writes an error response in the format of the IBM Cloud APIs.
+func WriteMockError(w http.ResponseWriter, status int, code, message string) {
+	WriteMockJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"code":    code,
+				"message": message,
+			},
+		},
+		"trace": "mock-trace",
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package unittest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockServerClientSession(t *testing.T) {
+	server := NewMockServer(t)
+	vpcs := server.Service("IBMCLOUD_IS_NG_API_ENDPOINT").Collection("/vpcs", &MockCollection{
+		ListKey: "vpcs",
+		New: func(id string, body map[string]interface{}) map[string]interface{} {
+			body["id"] = id
+			body["status"] = "available"
+			return body
+		},
+	})
+
+	session := server.ClientSession()
+	userDetails, err := session.BluemixUserDetails()
+	assert.Nil(t, err)
+	assert.Equal(t, MockAccountID, userDetails.UserAccount)
+	assert.Equal(t, MockUserID, userDetails.UserID)
+
+	vpcClient, err := session.VpcV1API()
+	assert.Nil(t, err)
+
+	vpc, _, err := vpcClient.CreateVPC(&vpcv1.CreateVPCOptions{Name: core.StringPtr("vpc1")})
+	assert.Nil(t, err)
+	assert.Equal(t, "vpc1", *vpc.Name)
+
+	_, found := vpcs.Get(*vpc.ID)
+	assert.True(t, found)
+
+	vpc, _, err = vpcClient.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+	assert.Nil(t, err)
+	assert.Equal(t, "available", *vpc.Status)
+
+	_, err = vpcClient.DeleteVPC(&vpcv1.DeleteVPCOptions{ID: vpc.ID})
+	assert.Nil(t, err)
+
+	_, response, err := vpcClient.GetVPC(&vpcv1.GetVPCOptions{ID: vpc.ID})
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestMockServerNotMocked(t *testing.T) {
+	server := NewMockServer(t)
+	server.Service("IBMCLOUD_IS_NG_API_ENDPOINT").Respond("GET /regions", http.StatusOK, `{"regions": []}`)
+
+	response, err := http.Get(server.Service("IBMCLOUD_IS_NG_API_ENDPOINT").URL() + "/regions")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	response, err = http.Get(server.Service("IBMCLOUD_IS_NG_API_ENDPOINT").URL() + "/vpcs")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "/is-ng/vpcs", requests[1].Path)
+}
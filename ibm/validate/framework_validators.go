@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Validators of the attributes of the framework actions and resources
+
+var (
+	_ validator.String = durationValidator{}
+	_ validator.String = stringOneOfValidator{}
+)
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "string must be a valid duration format (e.g., '30m', '1h')"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "string must be a valid duration format (e.g., `30m`, `1h`)"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	val := req.ConfigValue.ValueString()
+	if _, err := time.ParseDuration(val); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timeout Format",
+			fmt.Sprintf("Failed to parse timeout '%s': %s. Expected format like '30m' or '1h'.", val, err.Error()),
+		)
+	}
+}
+
+// ValidDuration returns the validator of a duration string, such as a timeout of `30m`
+func ValidDuration() validator.String {
+	return durationValidator{}
+}
+
+type stringOneOfValidator struct {
+	values []string
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("string must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("string must be one of: `%s`", strings.Join(v.values, "`, `"))
+}
+
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	val := req.ConfigValue.ValueString()
+	for _, value := range v.values {
+		if val == value {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Value '%s' is not valid, expected one of: %s.", val, strings.Join(v.values, ", ")),
+	)
+}
+
+// StringOneOf returns the validator of a string that must be one of the values, the framework
+// counterpart of ValidateAllowedStringValues
+func StringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
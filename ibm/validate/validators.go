@@ -1236,6 +1236,18 @@ func SetValidatorDict(v ValidatorDict) {
 	validatorDict = v
 }
 
+// InvocationRecorder is called with every lookup of a validator by InvokeValidator and
+// InvokeDataSourceValidator, and whether the validator was found
+type InvocationRecorder func(dataSource bool, resourceName, identifier string, found bool)
+
+var invocationRecorder InvocationRecorder
+
+// SetInvocationRecorder sets the recorder of the lookups of the validators, which lets the schema
+// tests find the validators that are referenced but not registered. A nil recorder stops recording.
+func SetInvocationRecorder(recorder InvocationRecorder) {
+	invocationRecorder = recorder
+}
+
 // This is the main validation function. This function will be used in all the provider code.
 func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc {
 	// Loop through dictionary and identify the resource and then the parameter configuration.
@@ -1252,6 +1264,9 @@ func InvokeValidator(resourceName, identifier string) schema.SchemaValidateFunc
 			}
 		}
 	}
+	if invocationRecorder != nil {
+		invocationRecorder(false, resourceName, identifier, found)
+	}
 
 	if found {
 		return invokeValidatorInternal(schemaToInvoke)
@@ -1277,6 +1292,9 @@ func InvokeDataSourceValidator(resourceName, identifier string) schema.SchemaVal
 			}
 		}
 	}
+	if invocationRecorder != nil {
+		invocationRecorder(true, resourceName, identifier, found)
+	}
 
 	if found {
 		return invokeValidatorInternal(schemaToInvoke)